@@ -0,0 +1,303 @@
+package gotypes
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// BigFloatPrecision is the mantissa precision in bits of *big.Float
+// values parsed from strings or converted from rationals
+var BigFloatPrecision uint = 128
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigRatType   = reflect.TypeOf(big.Rat{})
+)
+
+func ToBigInt(in interface{}) *big.Int {
+	castIn, _ := ToBigIntE(in)
+	return castIn
+}
+
+// ToBigIntE casts in to a new *big.Int. Fractional values are truncated
+// towards zero and reported with ErrNotInteger
+func ToBigIntE(in interface{}) (*big.Int, error) {
+	return NewCaster().ToBigIntE(in)
+}
+
+func (c *Caster) ToBigInt(in interface{}) *big.Int {
+	castIn, _ := c.ToBigIntE(in)
+	return castIn
+}
+
+func (c *Caster) ToBigIntE(in interface{}) (*big.Int, error) {
+	if castIn, ok, err := lookupCast[*big.Int](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn := new(big.Int)
+
+	value, err := c.ToBigRatE(in)
+	if err != nil {
+		return castIn, newCastError(in, bigIntType, errors.Unwrap(err))
+	}
+
+	castIn.Quo(value.Num(), value.Denom())
+	if !value.IsInt() {
+		return castIn, newCastError(in, bigIntType, ErrNotInteger)
+	}
+
+	return castIn, nil
+}
+
+func ToBigFloat(in interface{}) *big.Float {
+	castIn, _ := ToBigFloatE(in)
+	return castIn
+}
+
+// ToBigFloatE casts in to a new *big.Float with BigFloatPrecision bits
+func ToBigFloatE(in interface{}) (*big.Float, error) {
+	return NewCaster().ToBigFloatE(in)
+}
+
+func (c *Caster) ToBigFloat(in interface{}) *big.Float {
+	castIn, _ := c.ToBigFloatE(in)
+	return castIn
+}
+
+func (c *Caster) ToBigFloatE(in interface{}) (*big.Float, error) {
+	if castIn, ok, err := lookupCast[*big.Float](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn := new(big.Float).SetPrec(c.BigFloatPrecision)
+
+	switch v := in.(type) {
+	case *big.Float:
+		if v != nil {
+			return castIn.Set(v), nil
+		}
+
+	case float32:
+		if math.IsNaN(float64(v)) {
+			return castIn, newCastError(in, bigFloatType, ErrNaN)
+		}
+
+		return castIn.SetFloat64(float64(v)), nil
+
+	case float64:
+		if math.IsNaN(v) {
+			return castIn, newCastError(in, bigFloatType, ErrNaN)
+		}
+
+		return castIn.SetFloat64(v), nil
+
+	case string:
+		if f, _, err := castIn.Parse(strings.TrimSpace(v), 0); err == nil {
+			return f, nil
+		}
+	}
+
+	value, err := c.ToBigRatE(in)
+	if err != nil {
+		return castIn, newCastError(in, bigFloatType, errors.Unwrap(err))
+	}
+
+	return castIn.SetRat(value), nil
+}
+
+func ToBigRat(in interface{}) *big.Rat {
+	castIn, _ := ToBigRatE(in)
+	return castIn
+}
+
+// ToBigRatE casts in to a new *big.Rat. Strings may be integers with
+// base prefixes, decimals ("1.25", "1e-3") or fractions ("1/3")
+func ToBigRatE(in interface{}) (*big.Rat, error) {
+	return NewCaster().ToBigRatE(in)
+}
+
+func (c *Caster) ToBigRat(in interface{}) *big.Rat {
+	castIn, _ := c.ToBigRatE(in)
+	return castIn
+}
+
+func (c *Caster) ToBigRatE(in interface{}) (*big.Rat, error) {
+	if castIn, ok, err := lookupCast[*big.Rat](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn := new(big.Rat)
+
+	switch v := in.(type) {
+	case *big.Rat:
+		if v != nil {
+			return castIn.Set(v), nil
+		}
+
+	case *big.Int:
+		if v != nil {
+			return castIn.SetInt(v), nil
+		}
+
+	case *big.Float:
+		if v != nil {
+			if v.IsInf() {
+				return castIn, newCastError(in, bigRatType, ErrNaN)
+			}
+
+			v.Rat(castIn)
+			return castIn, nil
+		}
+
+	case big.Rat, big.Int, big.Float:
+		ptr := reflect.New(reflect.TypeOf(v))
+		ptr.Elem().Set(reflect.ValueOf(v))
+
+		return c.ToBigRatE(ptr.Interface())
+
+	case string:
+		s := strings.TrimSpace(v)
+
+		if i, ok := new(big.Int).SetString(s, 0); ok {
+			return castIn.SetInt(i), nil
+		}
+
+		if _, ok := castIn.SetString(s); ok {
+			return castIn, nil
+		}
+
+		return castIn, newCastError(in, bigRatType, ErrInvalidNumber)
+
+	case json.Number:
+		value, err := jsonNumberToRat(v)
+		if err != nil {
+			return castIn, newCastError(in, bigRatType, err)
+		}
+
+		return value, nil
+
+	case []byte:
+		return c.ToBigRatE(string(v))
+
+	case bool:
+		if v {
+			castIn.SetInt64(1)
+		}
+
+		return castIn, nil
+
+	case float32:
+		return c.ToBigRatE(float64(v))
+
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return castIn, newCastError(in, bigRatType, ErrNaN)
+		}
+
+		return castIn.SetFloat64(v), nil
+	}
+
+	if isNil(in) {
+		return castIn, newCastError(in, bigRatType, ErrNil)
+	}
+
+	if value, ok, err := unwrapValuer(in); ok {
+		if err != nil {
+			return castIn, newCastError(in, bigRatType, err)
+		}
+
+		return c.ToBigRatE(value)
+	}
+
+	value := reflect.ValueOf(in)
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return castIn.SetInt64(value.Int()), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return castIn.SetInt(new(big.Int).SetUint64(value.Uint())), nil
+	}
+
+	if underlying, ok := toUnderlying(in); ok {
+		return c.ToBigRatE(underlying)
+	}
+
+	if value.Kind() == reflect.Ptr {
+		return c.ToBigRatE(value.Elem().Interface())
+	}
+
+	return castIn, newCastError(in, bigRatType, ErrUnsupportedType)
+}
+
+// bigToInt64 converts *big.Int, *big.Float and *big.Rat values to int64.
+// Fractional values are rounded exactly with the Rounding of the Caster
+func (c *Caster) bigToInt64(in interface{}) (int64, error) {
+	value, err := c.ToBigRatE(in)
+	if err != nil {
+		return 0, errors.Unwrap(err)
+	}
+
+	integer, errRound := c.Rounding.roundRat(value)
+
+	switch {
+	case integer.IsInt64():
+		return integer.Int64(), errRound
+
+	case integer.Sign() < 0:
+		return math.MinInt64, ErrOverflow
+	}
+
+	return math.MaxInt64, ErrOverflow
+}
+
+// bigToUint64 is the unsigned counterpart of bigToInt64
+func (c *Caster) bigToUint64(in interface{}) (uint64, error) {
+	value, err := c.ToBigRatE(in)
+	if err != nil {
+		return 0, errors.Unwrap(err)
+	}
+
+	integer, errRound := c.Rounding.roundRat(value)
+
+	switch {
+	case integer.Sign() < 0:
+		return 0, ErrNegative
+
+	case integer.IsUint64():
+		return integer.Uint64(), errRound
+	}
+
+	return math.MaxUint64, ErrOverflow
+}
+
+// bigToFloat64 converts *big.Int, *big.Float and *big.Rat values to the
+// nearest float64, reporting ErrOverflow for values beyond its range
+func (c *Caster) bigToFloat64(in interface{}) (float64, error) {
+	if f, ok := in.(*big.Float); ok && f != nil {
+		castIn, _ := f.Float64()
+		if math.IsInf(castIn, 0) && !f.IsInf() {
+			return castIn, ErrOverflow
+		}
+
+		return castIn, nil
+	}
+
+	value, err := c.ToBigRatE(in)
+	if err != nil {
+		return 0, errors.Unwrap(err)
+	}
+
+	castIn, _ := value.Float64()
+	if math.IsInf(castIn, 0) {
+		return castIn, ErrOverflow
+	}
+
+	return castIn, nil
+}
@@ -0,0 +1,133 @@
+package gotypes
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Strings_ToBigInt(t *testing.T) {
+	cases := map[string]string{
+		"123456789012345678901234567890": "123456789012345678901234567890",
+		" -42 ":                          "-42",
+		"0x1f":                           "31",
+		"1e3":                            "1000",
+		"10/2":                           "5",
+	}
+
+	for in, out := range cases {
+		result, err := ToBigIntE(in)
+
+		assert.NoError(t, err)
+		assert.Exactly(t, result.String(), out)
+	}
+}
+
+func Test_Numbers_ToBigInt(t *testing.T) {
+	assert.Exactly(t, ToBigInt(int8(-8)).String(), "-8")
+	assert.Exactly(t, ToBigInt(uint64(math.MaxUint64)).String(), "18446744073709551615")
+	assert.Exactly(t, ToBigInt(float64(1e20)).String(), "100000000000000000000")
+	assert.Exactly(t, ToBigInt(true).String(), "1")
+	assert.Exactly(t, ToBigInt([]byte("7")).String(), "7")
+}
+
+func Test_FractionalString_ToBigIntError(t *testing.T) {
+	result, err := ToBigIntE("-2.5")
+
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, result.String(), "-2")
+}
+
+func Test_InvalidString_ToBigIntError(t *testing.T) {
+	_, err := ToBigIntE("abc")
+
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+}
+
+func Test_Nil_ToBigRatError(t *testing.T) {
+	var in *big.Int
+
+	_, err := ToBigRatE(in)
+
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_Strings_ToBigRat(t *testing.T) {
+	assert.Exactly(t, ToBigRat("1/3").String(), "1/3")
+	assert.Exactly(t, ToBigRat("0.25").String(), "1/4")
+	assert.Exactly(t, ToBigRat(*big.NewInt(3)).String(), "3/1")
+}
+
+func Test_Strings_ToBigFloat(t *testing.T) {
+	result, err := ToBigFloatE("3.14159265358979323846264338327950288")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result.Prec(), BigFloatPrecision)
+	assert.Exactly(t, result.Text('f', 30), "3.141592653589793238462643383280")
+}
+
+func Test_NaN_ToBigFloatError(t *testing.T) {
+	_, err := ToBigFloatE(math.NaN())
+
+	assert.ErrorIs(t, err, ErrNaN)
+}
+
+func Test_BigValues_ToInt64(t *testing.T) {
+	assert.Exactly(t, ToInt64(big.NewInt(-42)), int64(-42))
+	assert.Exactly(t, ToInt64(big.NewFloat(2.9)), int64(2))
+	assert.Exactly(t, ToInt64(big.NewRat(7, 2)), int64(3))
+}
+
+func Test_BigRatLargeFraction_ToInt64(t *testing.T) {
+	in := new(big.Rat).SetFrac(big.NewInt(1<<62+1), big.NewInt(2))
+
+	c := NewCaster()
+	c.Rounding = RoundHalfUp
+
+	assert.Exactly(t, c.ToInt64(in), int64(1<<61+1))
+	assert.Exactly(t, c.ToUint64(in), uint64(1<<61+1))
+	assert.Exactly(t, ToInt64(in), int64(1<<61))
+
+	c.Rounding = RoundHalfEven
+
+	assert.Exactly(t, c.ToInt64(new(big.Rat).Neg(in)), int64(-(1 << 61)))
+
+	c.Rounding = RoundFloor
+
+	assert.Exactly(t, c.ToInt64(big.NewRat(-7, 2)), int64(-4))
+}
+
+func Test_BigIntOverflow_ToInt64Error(t *testing.T) {
+	in, _ := new(big.Int).SetString("-100000000000000000000", 10)
+
+	result, err := ToInt64E(in)
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Exactly(t, result, int64(math.MinInt64))
+}
+
+func Test_BigIntNegative_ToUint64Error(t *testing.T) {
+	_, err := ToUint64E(big.NewInt(-1))
+
+	assert.ErrorIs(t, err, ErrNegative)
+}
+
+func Test_BigValues_ToFloat64(t *testing.T) {
+	assert.Exactly(t, ToFloat64(big.NewRat(1, 4)), 0.25)
+	assert.Exactly(t, ToFloat64(big.NewInt(1<<40)), float64(1<<40))
+}
+
+func Test_BigFloatOverflow_ToFloat64Error(t *testing.T) {
+	in, _, _ := big.ParseFloat("1e400", 10, 64, big.ToNearestEven)
+
+	result, err := ToFloat64E(in)
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.True(t, math.IsInf(result, 1))
+}
+
+func Test_BigFloat_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(big.NewFloat(0.1)), "0.1")
+}
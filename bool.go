@@ -0,0 +1,112 @@
+package gotypes
+
+import (
+	"strings"
+	"sync"
+)
+
+// DefaultBoolVocabulary is used by ToBool and ToBoolE
+var DefaultBoolVocabulary = NewBoolVocabulary(
+	[]string{"1", "t", "true", "y", "yes", "on", "enabled"},
+	[]string{"0", "f", "false", "n", "no", "off", "disabled"},
+)
+
+// BoolVocabulary holds the case-insensitive words recognized as true
+// or false. Unknown non-empty words are treated as true unless the
+// vocabulary is strict, in which case they are reported as an error
+type BoolVocabulary struct {
+	mutex  sync.RWMutex
+	words  map[string]bool
+	strict bool
+}
+
+func NewBoolVocabulary(truthy []string, falsy []string) *BoolVocabulary {
+	v := &BoolVocabulary{
+		words: map[string]bool{},
+	}
+
+	v.AddTrue(truthy...)
+	v.AddFalse(falsy...)
+
+	return v
+}
+
+func (v *BoolVocabulary) AddTrue(words ...string) {
+	v.add(true, words)
+}
+
+func (v *BoolVocabulary) AddFalse(words ...string) {
+	v.add(false, words)
+}
+
+func (v *BoolVocabulary) Remove(words ...string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	for _, word := range words {
+		delete(v.words, normalizeBoolWord(word))
+	}
+}
+
+// SetStrict makes unknown words an error instead of true
+func (v *BoolVocabulary) SetStrict(strict bool) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.strict = strict
+}
+
+func (v *BoolVocabulary) IsStrict() bool {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	return v.strict
+}
+
+func (v *BoolVocabulary) add(value bool, words []string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	for _, word := range words {
+		v.words[normalizeBoolWord(word)] = value
+	}
+}
+
+// Parse looks up s in the vocabulary. An empty string is false
+func (v *BoolVocabulary) Parse(s string) (bool, error) {
+	s = normalizeBoolWord(s)
+	if s == "" {
+		return false, nil
+	}
+
+	v.mutex.RLock()
+	value, ok := v.words[s]
+	strict := v.strict
+	v.mutex.RUnlock()
+
+	if ok {
+		return value, nil
+	}
+
+	if strict {
+		return false, ErrUnknownBool
+	}
+
+	return true, nil
+}
+
+func (v *BoolVocabulary) ToBool(in interface{}) bool {
+	castIn, _ := v.ToBoolE(in)
+	return castIn
+}
+
+func (v *BoolVocabulary) ToBoolE(in interface{}) (bool, error) {
+	c := NewCaster()
+	c.BoolVocabulary = v
+
+	return c.ToBoolE(in)
+}
+
+func normalizeBoolWord(word string) string {
+	return strings.ToLower(strings.TrimSpace(word))
+}
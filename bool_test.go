@@ -0,0 +1,78 @@
+package gotypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StringFalsyWords_ToBoolFalse(t *testing.T) {
+	for _, val := range []string{"no", "off", "n", "disabled", "NO", " Off ", "F"} {
+		result, err := ToBoolE(val)
+
+		assert.False(t, result, val)
+		assert.NoError(t, err, val)
+	}
+}
+
+func Test_StringTruthyWords_ToBoolTrue(t *testing.T) {
+	for _, val := range []string{"yes", "on", "y", "enabled", "YES", "True"} {
+		result, err := ToBoolE(val)
+
+		assert.True(t, result, val)
+		assert.NoError(t, err, val)
+	}
+}
+
+func Test_StringUnknownWordNotStrict_ToBoolTrue(t *testing.T) {
+	vocabulary := NewBoolVocabulary([]string{"true"}, []string{"false"})
+
+	result, err := vocabulary.ToBoolE("maybe")
+
+	assert.True(t, result)
+	assert.NoError(t, err)
+}
+
+func Test_StringUnknownWordStrict_ToBoolError(t *testing.T) {
+	vocabulary := NewBoolVocabulary([]string{"true"}, []string{"false"})
+	vocabulary.SetStrict(true)
+
+	assert.True(t, vocabulary.IsStrict())
+
+	result, err := vocabulary.ToBoolE("maybe")
+
+	assert.False(t, result)
+	assert.ErrorIs(t, err, ErrUnknownBool)
+}
+
+func Test_StringEmptyStrict_ToBoolFalse(t *testing.T) {
+	vocabulary := NewBoolVocabulary(nil, nil)
+	vocabulary.SetStrict(true)
+
+	result, err := vocabulary.ToBoolE("")
+
+	assert.False(t, result)
+	assert.NoError(t, err)
+}
+
+func Test_CustomWords_ToBool(t *testing.T) {
+	vocabulary := NewBoolVocabulary([]string{"true"}, []string{"false"})
+	vocabulary.AddTrue("Ja")
+	vocabulary.AddFalse("Nein")
+	vocabulary.SetStrict(true)
+
+	assert.True(t, vocabulary.ToBool("ja"))
+	assert.False(t, vocabulary.ToBool("NEIN"))
+
+	vocabulary.Remove("ja")
+
+	_, err := vocabulary.ToBoolE("ja")
+	assert.ErrorIs(t, err, ErrUnknownBool)
+}
+
+func Test_StringPointer_VocabularyToBool(t *testing.T) {
+	vocabulary := NewBoolVocabulary([]string{"da"}, []string{"net"})
+	val := "da"
+
+	assert.True(t, vocabulary.ToBool(&val))
+}
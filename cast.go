@@ -1,29 +1,79 @@
 package gotypes
 
 import (
+	"encoding"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	boolType    = reflect.TypeOf(false)
+	stringType  = reflect.TypeOf("")
+	uint64Type  = reflect.TypeOf(uint64(0))
+	int64Type   = reflect.TypeOf(int64(0))
+	float32Type = reflect.TypeOf(float32(0))
+	float64Type = reflect.TypeOf(float64(0))
+
+	kindTypes = map[reflect.Kind]reflect.Type{
+		reflect.Bool:    boolType,
+		reflect.String:  stringType,
+		reflect.Int:     reflect.TypeOf(int(0)),
+		reflect.Int8:    reflect.TypeOf(int8(0)),
+		reflect.Int16:   reflect.TypeOf(int16(0)),
+		reflect.Int32:   reflect.TypeOf(int32(0)),
+		reflect.Int64:   int64Type,
+		reflect.Uint:    reflect.TypeOf(uint(0)),
+		reflect.Uint8:   reflect.TypeOf(uint8(0)),
+		reflect.Uint16:  reflect.TypeOf(uint16(0)),
+		reflect.Uint32:  reflect.TypeOf(uint32(0)),
+		reflect.Uint64:  uint64Type,
+		reflect.Float32: float32Type,
+		reflect.Float64: float64Type,
+
+		reflect.Complex64:  complex64Type,
+		reflect.Complex128: complex128Type,
+	}
 )
 
 func ToBool(in interface{}) bool {
-	var (
-		castIn bool
-		err    error
-	)
+	castIn, _ := ToBoolE(in)
+	return castIn
+}
+
+func ToBoolE(in interface{}) (bool, error) {
+	return NewCaster().ToBoolE(in)
+}
+
+func (c *Caster) ToBool(in interface{}) bool {
+	castIn, _ := c.ToBoolE(in)
+	return castIn
+}
+
+func (c *Caster) ToBoolE(in interface{}) (bool, error) {
+	if castIn, ok, err := lookupCast[bool](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	var castIn bool
 
 	switch v := in.(type) {
 	case bool:
 		castIn = v
 
 	case []byte:
-		castIn = ToBool(string(v))
+		return c.ToBoolE(string(v))
 
 	case string:
-		castIn, err = strconv.ParseBool(v)
-
-		if err != nil && v != "" {
-			castIn = true
+		var err error
+		if castIn, err = c.BoolVocabulary.Parse(v); err != nil {
+			return castIn, newCastError(in, boolType, err)
 		}
 
 	case int:
@@ -63,15 +113,51 @@ func ToBool(in interface{}) bool {
 		castIn = v != 0
 
 	default:
+		if isNil(v) {
+			return castIn, newCastError(in, boolType, ErrNil)
+		}
+
+		if value, ok, err := unwrapValuer(v); ok {
+			if err != nil {
+				return castIn, newCastError(in, boolType, err)
+			}
+
+			return c.ToBoolE(value)
+		}
+
+		if underlying, ok := toUnderlying(v); ok {
+			return c.ToBoolE(underlying)
+		}
+
 		if reflect.ValueOf(v).Kind() == reflect.Ptr {
-			return ToBool(reflect.Indirect(reflect.ValueOf(v)).Interface())
+			return c.ToBoolE(reflect.Indirect(reflect.ValueOf(v)).Interface())
 		}
+
+		return castIn, newCastError(in, boolType, ErrUnsupportedType)
 	}
 
-	return castIn
+	return castIn, nil
 }
 
 func ToString(in interface{}) string {
+	castIn, _ := ToStringE(in)
+	return castIn
+}
+
+func ToStringE(in interface{}) (string, error) {
+	return NewCaster().ToStringE(in)
+}
+
+func (c *Caster) ToString(in interface{}) string {
+	castIn, _ := c.ToStringE(in)
+	return castIn
+}
+
+func (c *Caster) ToStringE(in interface{}) (string, error) {
+	if castIn, ok, err := lookupCast[string](c.Registry, in); ok {
+		return castIn, err
+	}
+
 	var castIn string
 
 	switch v := in.(type) {
@@ -84,6 +170,9 @@ func ToString(in interface{}) string {
 	case []byte:
 		castIn = string(v)
 
+	case json.Number:
+		castIn = string(v)
+
 	case int:
 		castIn = strconv.Itoa(v)
 
@@ -115,52 +204,199 @@ func ToString(in interface{}) string {
 		castIn = strconv.FormatUint(v, 10)
 
 	case float32:
-		castIn = strconv.FormatFloat(float64(v), 'f', 6, 32)
+		castIn = c.Formatter.Float.FormatFloat(float64(v), 32)
 
 	case float64:
-		castIn = strconv.FormatFloat(v, 'f', 6, 64)
+		castIn = c.Formatter.Float.FormatFloat(v, 64)
+
+	case complex64:
+		castIn = c.Formatter.Float.FormatComplex(complex128(v), 64)
+
+	case complex128:
+		castIn = c.Formatter.Float.FormatComplex(v, 128)
+
+	case time.Time:
+		castIn = c.Formatter.FormatTime(v)
 
 	default:
+		if isNil(v) {
+			return castIn, newCastError(in, stringType, ErrNil)
+		}
+
+		if value, ok, err := unwrapValuer(v); ok {
+			if err != nil {
+				return castIn, newCastError(in, stringType, err)
+			}
+
+			return c.ToStringE(value)
+		}
+
+		if t, ok := v.(*time.Time); ok {
+			return c.Formatter.FormatTime(*t), nil
+		}
+
+		if f, ok := v.(*big.Float); ok {
+			return f.Text('g', -1), nil
+		}
+
+		if marshaler, ok := v.(encoding.TextMarshaler); ok {
+			text, err := marshaler.MarshalText()
+			if err != nil {
+				return castIn, newCastError(in, stringType, err)
+			}
+
+			return string(text), nil
+		}
+
 		if stringer, ok := v.(fmt.Stringer); ok {
-			return stringer.String()
+			return stringer.String(), nil
 		}
 
 		if stringer, ok := v.(fmt.GoStringer); ok {
-			return stringer.GoString()
+			return stringer.GoString(), nil
+		}
+
+		if e, ok := v.(error); ok {
+			return e.Error(), nil
 		}
 
-		if cast, ok := in.(string); ok {
-			castIn = cast
-		} else if reflect.ValueOf(v).Kind() == reflect.Ptr {
-			return ToString(reflect.Indirect(reflect.ValueOf(v)).Interface())
+		if underlying, ok := toUnderlying(v); ok {
+			return c.ToStringE(underlying)
 		}
+
+		switch reflect.ValueOf(v).Kind() {
+		case reflect.Ptr:
+			return c.ToStringE(reflect.Indirect(reflect.ValueOf(v)).Interface())
+
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return c.formatCollection(v)
+		}
+
+		return castIn, newCastError(in, stringType, ErrUnsupportedType)
 	}
 
-	return castIn
+	return castIn, nil
 }
 
 func ToUint(in interface{}) uint {
-	return uint(ToUint64(in))
+	castIn, _ := ToUintE(in)
+	return castIn
+}
+
+func ToUintE(in interface{}) (uint, error) {
+	return NewCaster().ToUintE(in)
+}
+
+func (c *Caster) ToUint(in interface{}) uint {
+	castIn, _ := c.ToUintE(in)
+	return castIn
+}
+
+func (c *Caster) ToUintE(in interface{}) (uint, error) {
+	if castIn, ok, err := lookupCast[uint](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toUintRange(in, math.MaxUint, reflect.TypeOf(uint(0)))
+	return uint(castIn), err
 }
 
 func ToUint8(in interface{}) uint8 {
-	return uint8(ToUint64(in))
+	castIn, _ := ToUint8E(in)
+	return castIn
+}
+
+func ToUint8E(in interface{}) (uint8, error) {
+	return NewCaster().ToUint8E(in)
+}
+
+func (c *Caster) ToUint8(in interface{}) uint8 {
+	castIn, _ := c.ToUint8E(in)
+	return castIn
+}
+
+func (c *Caster) ToUint8E(in interface{}) (uint8, error) {
+	if castIn, ok, err := lookupCast[uint8](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toUintRange(in, math.MaxUint8, reflect.TypeOf(uint8(0)))
+	return uint8(castIn), err
 }
 
 func ToUint16(in interface{}) uint16 {
-	return uint16(ToUint64(in))
+	castIn, _ := ToUint16E(in)
+	return castIn
+}
+
+func ToUint16E(in interface{}) (uint16, error) {
+	return NewCaster().ToUint16E(in)
+}
+
+func (c *Caster) ToUint16(in interface{}) uint16 {
+	castIn, _ := c.ToUint16E(in)
+	return castIn
+}
+
+func (c *Caster) ToUint16E(in interface{}) (uint16, error) {
+	if castIn, ok, err := lookupCast[uint16](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toUintRange(in, math.MaxUint16, reflect.TypeOf(uint16(0)))
+	return uint16(castIn), err
 }
 
 func ToUint32(in interface{}) uint32 {
-	return uint32(ToUint64(in))
+	castIn, _ := ToUint32E(in)
+	return castIn
+}
+
+func ToUint32E(in interface{}) (uint32, error) {
+	return NewCaster().ToUint32E(in)
+}
+
+func (c *Caster) ToUint32(in interface{}) uint32 {
+	castIn, _ := c.ToUint32E(in)
+	return castIn
+}
+
+func (c *Caster) ToUint32E(in interface{}) (uint32, error) {
+	if castIn, ok, err := lookupCast[uint32](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toUintRange(in, math.MaxUint32, reflect.TypeOf(uint32(0)))
+	return uint32(castIn), err
 }
 
 func ToUint64(in interface{}) uint64 {
+	castIn, _ := ToUint64E(in)
+	return castIn
+}
+
+func ToUint64E(in interface{}) (uint64, error) {
+	return NewCaster().ToUint64E(in)
+}
+
+func (c *Caster) ToUint64(in interface{}) uint64 {
+	castIn, _ := c.ToUint64E(in)
+	return castIn
+}
+
+func (c *Caster) ToUint64E(in interface{}) (uint64, error) {
+	if castIn, ok, err := lookupCast[uint64](c.Registry, in); ok {
+		return castIn, err
+	}
+
 	var castIn uint64
 
 	switch v := in.(type) {
 	case string:
-		castIn, _ = strconv.ParseUint(v, 10, 64)
+		var err error
+		if castIn, err = c.parseUint(c.localize(v)); err != nil {
+			return castIn, newCastError(in, uint64Type, err)
+		}
 
 	case bool:
 		if v {
@@ -168,21 +404,41 @@ func ToUint64(in interface{}) uint64 {
 		}
 
 	case []byte:
-		castIn = ToUint64(string(v))
+		return c.ToUint64E(string(v))
 
 	case int64:
+		if v < 0 {
+			return 0, newCastError(in, uint64Type, ErrNegative)
+		}
+
 		castIn = uint64(v)
 
 	case int:
+		if v < 0 {
+			return 0, newCastError(in, uint64Type, ErrNegative)
+		}
+
 		castIn = uint64(v)
 
 	case int8:
+		if v < 0 {
+			return 0, newCastError(in, uint64Type, ErrNegative)
+		}
+
 		castIn = uint64(v)
 
 	case int16:
+		if v < 0 {
+			return 0, newCastError(in, uint64Type, ErrNegative)
+		}
+
 		castIn = uint64(v)
 
 	case int32:
+		if v < 0 {
+			return 0, newCastError(in, uint64Type, ErrNegative)
+		}
+
 		castIn = uint64(v)
 
 	case uint:
@@ -201,43 +457,175 @@ func ToUint64(in interface{}) uint64 {
 		castIn = v
 
 	case float32:
-		castIn = uint64(v)
+		var err error
+		if castIn, err = roundToUint64(c.Rounding, float64(v)); err != nil {
+			return castIn, newCastError(in, uint64Type, err)
+		}
 
 	case float64:
-		castIn = uint64(v)
+		var err error
+		if castIn, err = roundToUint64(c.Rounding, v); err != nil {
+			return castIn, newCastError(in, uint64Type, err)
+		}
+
+	case json.Number:
+		var err error
+		if castIn, err = c.jsonNumberToUint64(v); err != nil {
+			return castIn, newCastError(in, uint64Type, err)
+		}
+
+	case *big.Int, *big.Float, *big.Rat:
+		var err error
+		if castIn, err = c.bigToUint64(v); err != nil {
+			return castIn, newCastError(in, uint64Type, err)
+		}
 
 	default:
+		if isNil(v) {
+			return castIn, newCastError(in, uint64Type, ErrNil)
+		}
+
+		if value, ok, err := unwrapValuer(v); ok {
+			if err != nil {
+				return castIn, newCastError(in, uint64Type, err)
+			}
+
+			return c.ToUint64E(value)
+		}
+
+		if underlying, ok := toUnderlying(v); ok {
+			return c.ToUint64E(underlying)
+		}
+
 		if reflect.ValueOf(v).Kind() == reflect.Ptr {
-			return ToUint64(reflect.Indirect(reflect.ValueOf(v)).Interface())
+			return c.ToUint64E(reflect.Indirect(reflect.ValueOf(v)).Interface())
 		}
+
+		return castIn, newCastError(in, uint64Type, ErrUnsupportedType)
 	}
 
-	return castIn
+	return castIn, nil
 }
 
 func ToInt(in interface{}) int {
-	return int(ToInt64(in))
+	castIn, _ := ToIntE(in)
+	return castIn
+}
+
+func ToIntE(in interface{}) (int, error) {
+	return NewCaster().ToIntE(in)
+}
+
+func (c *Caster) ToInt(in interface{}) int {
+	castIn, _ := c.ToIntE(in)
+	return castIn
+}
+
+func (c *Caster) ToIntE(in interface{}) (int, error) {
+	if castIn, ok, err := lookupCast[int](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toIntRange(in, math.MinInt, math.MaxInt, reflect.TypeOf(int(0)))
+	return int(castIn), err
 }
 
 func ToInt8(in interface{}) int8 {
-	return int8(ToInt64(in))
+	castIn, _ := ToInt8E(in)
+	return castIn
+}
+
+func ToInt8E(in interface{}) (int8, error) {
+	return NewCaster().ToInt8E(in)
+}
+
+func (c *Caster) ToInt8(in interface{}) int8 {
+	castIn, _ := c.ToInt8E(in)
+	return castIn
+}
+
+func (c *Caster) ToInt8E(in interface{}) (int8, error) {
+	if castIn, ok, err := lookupCast[int8](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toIntRange(in, math.MinInt8, math.MaxInt8, reflect.TypeOf(int8(0)))
+	return int8(castIn), err
 }
 
 func ToInt16(in interface{}) int16 {
-	return int16(ToInt64(in))
+	castIn, _ := ToInt16E(in)
+	return castIn
+}
+
+func ToInt16E(in interface{}) (int16, error) {
+	return NewCaster().ToInt16E(in)
+}
+
+func (c *Caster) ToInt16(in interface{}) int16 {
+	castIn, _ := c.ToInt16E(in)
+	return castIn
+}
+
+func (c *Caster) ToInt16E(in interface{}) (int16, error) {
+	if castIn, ok, err := lookupCast[int16](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toIntRange(in, math.MinInt16, math.MaxInt16, reflect.TypeOf(int16(0)))
+	return int16(castIn), err
 }
 
 func ToInt32(in interface{}) int32 {
-	return int32(ToInt64(in))
+	castIn, _ := ToInt32E(in)
+	return castIn
+}
+
+func ToInt32E(in interface{}) (int32, error) {
+	return NewCaster().ToInt32E(in)
+}
+
+func (c *Caster) ToInt32(in interface{}) int32 {
+	castIn, _ := c.ToInt32E(in)
+	return castIn
+}
+
+func (c *Caster) ToInt32E(in interface{}) (int32, error) {
+	if castIn, ok, err := lookupCast[int32](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.toIntRange(in, math.MinInt32, math.MaxInt32, reflect.TypeOf(int32(0)))
+	return int32(castIn), err
 }
 
 func ToInt64(in interface{}) int64 {
+	castIn, _ := ToInt64E(in)
+	return castIn
+}
+
+func ToInt64E(in interface{}) (int64, error) {
+	return NewCaster().ToInt64E(in)
+}
+
+func (c *Caster) ToInt64(in interface{}) int64 {
+	castIn, _ := c.ToInt64E(in)
+	return castIn
+}
+
+func (c *Caster) ToInt64E(in interface{}) (int64, error) {
+	if castIn, ok, err := lookupCast[int64](c.Registry, in); ok {
+		return castIn, err
+	}
+
 	var castIn int64
 
 	switch v := in.(type) {
 	case string:
-		t, _ := strconv.Atoi(v)
-		castIn = int64(t)
+		var err error
+		if castIn, err = c.parseInt(c.localize(v)); err != nil {
+			return castIn, newCastError(in, int64Type, err)
+		}
 
 	case bool:
 		if v {
@@ -245,7 +633,7 @@ func ToInt64(in interface{}) int64 {
 		}
 
 	case []byte:
-		castIn = ToInt64(string(v))
+		return c.ToInt64E(string(v))
 
 	case int64:
 		castIn = v
@@ -263,6 +651,10 @@ func ToInt64(in interface{}) int64 {
 		castIn = int64(v)
 
 	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return math.MaxInt64, newCastError(in, int64Type, ErrOverflow)
+		}
+
 		castIn = int64(v)
 
 	case uint8:
@@ -275,33 +667,125 @@ func ToInt64(in interface{}) int64 {
 		castIn = int64(v)
 
 	case uint64:
+		if uint64(v) > math.MaxInt64 {
+			return math.MaxInt64, newCastError(in, int64Type, ErrOverflow)
+		}
+
 		castIn = int64(v)
 
 	case float32:
-		castIn = int64(v)
+		var err error
+		if castIn, err = roundToInt64(c.Rounding, float64(v)); err != nil {
+			return castIn, newCastError(in, int64Type, err)
+		}
 
 	case float64:
-		castIn = int64(v)
+		var err error
+		if castIn, err = roundToInt64(c.Rounding, v); err != nil {
+			return castIn, newCastError(in, int64Type, err)
+		}
+
+	case json.Number:
+		var err error
+		if castIn, err = c.jsonNumberToInt64(v); err != nil {
+			return castIn, newCastError(in, int64Type, err)
+		}
+
+	case *big.Int, *big.Float, *big.Rat:
+		var err error
+		if castIn, err = c.bigToInt64(v); err != nil {
+			return castIn, newCastError(in, int64Type, err)
+		}
 
 	default:
+		if isNil(v) {
+			return castIn, newCastError(in, int64Type, ErrNil)
+		}
+
+		if value, ok, err := unwrapValuer(v); ok {
+			if err != nil {
+				return castIn, newCastError(in, int64Type, err)
+			}
+
+			return c.ToInt64E(value)
+		}
+
+		if underlying, ok := toUnderlying(v); ok {
+			return c.ToInt64E(underlying)
+		}
+
 		if reflect.ValueOf(v).Kind() == reflect.Ptr {
-			return ToInt64(reflect.Indirect(reflect.ValueOf(v)).Interface())
+			return c.ToInt64E(reflect.Indirect(reflect.ValueOf(v)).Interface())
 		}
+
+		return castIn, newCastError(in, int64Type, ErrUnsupportedType)
 	}
 
-	return castIn
+	return castIn, nil
 }
 
 func ToFloat32(in interface{}) float32 {
-	return float32(ToFloat64(in))
+	castIn, _ := ToFloat32E(in)
+	return castIn
+}
+
+func ToFloat32E(in interface{}) (float32, error) {
+	return NewCaster().ToFloat32E(in)
+}
+
+func (c *Caster) ToFloat32(in interface{}) float32 {
+	castIn, _ := c.ToFloat32E(in)
+	return castIn
+}
+
+func (c *Caster) ToFloat32E(in interface{}) (float32, error) {
+	if castIn, ok, err := lookupCast[float32](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.ToFloat64E(in)
+	if err != nil {
+		return float32(castIn), err
+	}
+
+	switch {
+	case castIn > math.MaxFloat32 && !math.IsInf(castIn, 1):
+		return math.MaxFloat32, newCastError(in, float32Type, ErrOverflow)
+
+	case castIn < -math.MaxFloat32 && !math.IsInf(castIn, -1):
+		return -math.MaxFloat32, newCastError(in, float32Type, ErrOverflow)
+	}
+
+	return float32(castIn), nil
 }
 
 func ToFloat64(in interface{}) float64 {
+	castIn, _ := ToFloat64E(in)
+	return castIn
+}
+
+func ToFloat64E(in interface{}) (float64, error) {
+	return NewCaster().ToFloat64E(in)
+}
+
+func (c *Caster) ToFloat64(in interface{}) float64 {
+	castIn, _ := c.ToFloat64E(in)
+	return castIn
+}
+
+func (c *Caster) ToFloat64E(in interface{}) (float64, error) {
+	if castIn, ok, err := lookupCast[float64](c.Registry, in); ok {
+		return castIn, err
+	}
+
 	var castIn float64
 
 	switch v := in.(type) {
 	case string:
-		castIn, _ = strconv.ParseFloat(v, 64)
+		var err error
+		if castIn, err = strconv.ParseFloat(strings.TrimSpace(c.localize(v)), 64); err != nil {
+			return castIn, newCastError(in, float64Type, err)
+		}
 
 	case bool:
 		if v {
@@ -309,7 +793,7 @@ func ToFloat64(in interface{}) float64 {
 		}
 
 	case []byte:
-		castIn = ToFloat64(string(v))
+		return c.ToFloat64E(string(v))
 
 	case float64:
 		castIn = v
@@ -347,11 +831,326 @@ func ToFloat64(in interface{}) float64 {
 	case float32:
 		castIn = float64(v)
 
+	case json.Number:
+		var err error
+		if castIn, err = jsonNumberToFloat64(v); err != nil {
+			return castIn, newCastError(in, float64Type, err)
+		}
+
+	case *big.Int, *big.Float, *big.Rat:
+		var err error
+		if castIn, err = c.bigToFloat64(v); err != nil {
+			return castIn, newCastError(in, float64Type, err)
+		}
+
+	case complex64:
+		var err error
+		if castIn, err = c.complexToFloat64(complex128(v)); err != nil {
+			return castIn, newCastError(in, float64Type, err)
+		}
+
+	case complex128:
+		var err error
+		if castIn, err = c.complexToFloat64(v); err != nil {
+			return castIn, newCastError(in, float64Type, err)
+		}
+
 	default:
+		if isNil(v) {
+			return castIn, newCastError(in, float64Type, ErrNil)
+		}
+
+		if value, ok, err := unwrapValuer(v); ok {
+			if err != nil {
+				return castIn, newCastError(in, float64Type, err)
+			}
+
+			return c.ToFloat64E(value)
+		}
+
+		if underlying, ok := toUnderlying(v); ok {
+			return c.ToFloat64E(underlying)
+		}
+
 		if reflect.ValueOf(v).Kind() == reflect.Ptr {
-			return ToFloat64(reflect.Indirect(reflect.ValueOf(v)).Interface())
+			return c.ToFloat64E(reflect.Indirect(reflect.ValueOf(v)).Interface())
 		}
+
+		return castIn, newCastError(in, float64Type, ErrUnsupportedType)
 	}
 
-	return castIn
+	return castIn, nil
+}
+
+// isNil reports whether in is a nil interface or a nil pointer
+func isNil(in interface{}) bool {
+	if in == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(in)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
+
+// indirect dereferences in down to a non-pointer value, reporting
+// false if a nil is met at any level
+func indirect(in interface{}) (interface{}, bool) {
+	value := reflect.ValueOf(in)
+
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, false
+		}
+
+		value = value.Elem()
+	}
+
+	if !value.IsValid() {
+		return nil, false
+	}
+
+	return value.Interface(), true
+}
+
+// toUnderlying converts a value of a named type (e.g. `type Status int`)
+// to the built-in type matching its kind
+func toUnderlying(in interface{}) (interface{}, bool) {
+	value := reflect.ValueOf(in)
+
+	if t, ok := kindTypes[value.Kind()]; ok && value.Type() != t {
+		return value.Convert(t).Interface(), true
+	}
+
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+		return value.Bytes(), true
+	}
+
+	return nil, false
+}
+
+// stringLike returns the string form of string kinds and byte slices,
+// including pointers to them
+func stringLike(in interface{}) (string, bool) {
+	if value, ok := indirect(in); ok {
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.String:
+			return reflect.ValueOf(value).String(), true
+
+		case reflect.Slice:
+			if b, ok := value.([]byte); ok {
+				return string(b), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// toIntRange casts in to int64 and saturates the result to [min, max],
+// reporting ErrOverflow for values that had to be clamped. Range errors
+// of the int64 cast, such as ErrNaN, are kept
+func (c *Caster) toIntRange(in interface{}, min, max int64, target reflect.Type) (int64, error) {
+	castIn, err := c.ToInt64E(in)
+
+	if castErr, ok := err.(*CastError); ok {
+		castErr.Target = target
+	}
+
+	switch {
+	case castIn < min:
+		castIn = min
+
+	case castIn > max:
+		castIn = max
+
+	default:
+		return castIn, err
+	}
+
+	if !isRangeError(err) {
+		err = newCastError(in, target, ErrOverflow)
+	}
+
+	return castIn, err
+}
+
+// toUintRange casts in to uint64 and saturates the result to [0, max],
+// reporting ErrOverflow for values that had to be clamped. Range errors
+// of the uint64 cast, such as ErrNaN, are kept
+func (c *Caster) toUintRange(in interface{}, max uint64, target reflect.Type) (uint64, error) {
+	castIn, err := c.ToUint64E(in)
+
+	if castErr, ok := err.(*CastError); ok {
+		castErr.Target = target
+	}
+
+	if castIn <= max {
+		return castIn, err
+	}
+
+	if !isRangeError(err) {
+		err = newCastError(in, target, ErrOverflow)
+	}
+
+	return max, err
+}
+
+// localize normalizes s with the Locale of the Caster. Strings which
+// are not valid numbers in the locale are left untouched so the caller
+// reports them
+func (c *Caster) localize(s string) string {
+	if c.Locale == nil {
+		return s
+	}
+
+	if n, err := c.Locale.Normalize(s); err == nil {
+		return n
+	}
+
+	return s
+}
+
+// parseInt parses s with Go literal semantics: surrounding whitespace,
+// sign, base prefixes (0x, 0o, 0b) and underscores are allowed. Numbers
+// without a prefix are decimal even with leading zeros. Strings with
+// a decimal part are rounded with the Rounding of the Caster, with
+// RoundDefault they are rejected
+func (c *Caster) parseInt(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+
+	castIn, err := strconv.ParseInt(decimalLiteral(s), 0, 64)
+	if err == nil {
+		return castIn, nil
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return castIn, ErrOverflow
+	}
+
+	f, errFloat := strconv.ParseFloat(s, 64)
+	if errFloat != nil {
+		return 0, err
+	}
+
+	return roundToInt64(c.Rounding.decimal(), f)
+}
+
+// parseUint is the unsigned counterpart of parseInt
+func (c *Caster) parseUint(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+
+	castIn, err := strconv.ParseUint(decimalLiteral(s), 0, 64)
+	if err == nil {
+		return castIn, nil
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		return castIn, ErrOverflow
+	}
+
+	if i, errInt := strconv.ParseInt(decimalLiteral(s), 0, 64); errInt == nil && i < 0 {
+		return 0, ErrNegative
+	}
+
+	f, errFloat := strconv.ParseFloat(s, 64)
+	if errFloat != nil {
+		return 0, err
+	}
+
+	return roundToUint64(c.Rounding.decimal(), f)
+}
+
+// decimalLiteral drops leading zeros of s unless they start an explicit
+// 0x, 0o or 0b prefix, so strconv does not read "010" as an octal number
+func decimalLiteral(s string) string {
+	sign := ""
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		sign, s = s[:1], s[1:]
+	}
+
+	if len(s) > 1 && s[0] == '0' {
+		switch s[1] {
+		case 'x', 'X', 'o', 'O', 'b', 'B':
+			return sign + s
+		}
+	}
+
+	if len(s) > 0 && s[0] == '0' {
+		s = strings.TrimLeft(s, "0_")
+		if s == "" || s[0] < '0' || s[0] > '9' {
+			s = "0" + s
+		}
+	}
+
+	return sign + s
+}
+
+// roundToInt64 rounds v with the rounding and converts it to int64
+func roundToInt64(rounding Rounding, v float64) (int64, error) {
+	r, errRound := rounding.Round(v)
+
+	castIn, err := floatToInt64(r)
+	if err == nil {
+		err = errRound
+	}
+
+	return castIn, err
+}
+
+// roundToUint64 is the unsigned counterpart of roundToInt64
+func roundToUint64(rounding Rounding, v float64) (uint64, error) {
+	r, errRound := rounding.Round(v)
+
+	castIn, err := floatToUint64(r)
+	if err == nil {
+		err = errRound
+	}
+
+	return castIn, err
+}
+
+func floatToInt64(v float64) (int64, error) {
+	switch {
+	case math.IsNaN(v):
+		return 0, ErrNaN
+
+	case v >= math.MaxInt64:
+		if math.IsInf(v, 1) {
+			return math.MaxInt64, ErrNaN
+		}
+
+		return math.MaxInt64, ErrOverflow
+
+	case v < math.MinInt64:
+		if math.IsInf(v, -1) {
+			return math.MinInt64, ErrNaN
+		}
+
+		return math.MinInt64, ErrOverflow
+	}
+
+	return int64(v), nil
+}
+
+func floatToUint64(v float64) (uint64, error) {
+	switch {
+	case math.IsNaN(v):
+		return 0, ErrNaN
+
+	case v <= -1:
+		if math.IsInf(v, -1) {
+			return 0, ErrNaN
+		}
+
+		return 0, ErrNegative
+
+	case v >= math.MaxUint64:
+		if math.IsInf(v, 1) {
+			return math.MaxUint64, ErrNaN
+		}
+
+		return math.MaxUint64, ErrOverflow
+	}
+
+	return uint64(v), nil
 }
@@ -1,24 +1,215 @@
 package gotypes
 
 import (
+	"encoding/json"
+	"errors"
+	"reflect"
 	"time"
 )
 
-func ToTime(in interface{}) (t time.Time) {
-	var err error
-	v := ToString(in)
+func ToTime(in interface{}) time.Time {
+	t, _ := ToTimeE(in)
+	return t
+}
+
+func ToTimeE(in interface{}) (time.Time, error) {
+	return NewCaster().ToTimeE(in)
+}
+
+// ToTimeIn is the same as ToTime, but strings without a time zone are
+// interpreted in loc and Unix timestamps are returned in loc
+func ToTimeIn(in interface{}, loc *time.Location) time.Time {
+	t, _ := ToTimeInE(in, loc)
+	return t
+}
+
+func ToTimeInE(in interface{}, loc *time.Location) (time.Time, error) {
+	return NewCaster().ToTimeInE(in, loc)
+}
+
+func (c *Caster) ToTime(in interface{}) time.Time {
+	t, _ := c.ToTimeE(in)
+	return t
+}
+
+func (c *Caster) ToTimeE(in interface{}) (time.Time, error) {
+	return c.toTimeE(in, c.Location)
+}
+
+func (c *Caster) ToTimeIn(in interface{}, loc *time.Location) time.Time {
+	t, _ := c.ToTimeInE(in, loc)
+	return t
+}
+
+func (c *Caster) ToTimeInE(in interface{}, loc *time.Location) (time.Time, error) {
+	return c.toTimeE(in, loc)
+}
+
+func (c *Caster) toTimeE(in interface{}, loc *time.Location) (t time.Time, err error) {
+	if castIn, ok, err := lookupCast[time.Time](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	if isNil(in) {
+		return t, newCastError(in, timeType, ErrNil)
+	}
+
+	if value, ok, err := unwrapValuer(in); ok {
+		if err != nil {
+			return t, newCastError(in, timeType, err)
+		}
+
+		return c.toTimeE(value, loc)
+	}
+
+	switch v := in.(type) {
+	case time.Time:
+		return v, nil
+
+	case *time.Time:
+		return *v, nil
+
+	// decoded JSON numbers are Unix timestamps, the same as float64 ones
+	case json.Number:
+		if !jsonNumberRegexp.MatchString(string(v)) {
+			return t, newCastError(in, timeType, ErrInvalidNumber)
+		}
+
+		if i, err := v.Int64(); err == nil {
+			return c.toTimeE(i, loc)
+		}
+
+		f, err := v.Float64()
+		if err != nil {
+			return t, newCastError(in, timeType, ErrOverflow)
+		}
+
+		return c.toTimeE(f, loc)
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	switch reflect.ValueOf(in).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := c.ToInt64E(in)
+		if err != nil {
+			return t, newCastError(in, timeType, errors.Unwrap(err))
+		}
+
+		result, err := c.TimeLayouts.Unix(v, c.UnixUnit)
+		if err != nil {
+			return t, newCastError(in, timeType, err)
+		}
+
+		return result.In(loc), nil
 
-	t, err = time.Parse(time.RFC3339, v)
+	case reflect.Float32, reflect.Float64:
+		result, err := c.TimeLayouts.UnixFloat(c.ToFloat64(in), c.UnixUnit)
+		if err != nil {
+			return t, newCastError(in, timeType, err)
+		}
 
+		return result.In(loc), nil
+	}
+
+	v, err := c.ToStringE(in)
 	if err != nil {
-		t, err = time.Parse("02.01.2006 15:04:05", v)
+		return t, newCastError(in, timeType, ErrUnsupportedType)
 	}
 
-	return t
+	if t, err = c.TimeLayouts.ParseInLocation(v, loc); err != nil {
+		return t, newCastError(in, timeType, err)
+	}
+
+	return t, nil
 }
 
-func ToDuration(in interface{}) (d time.Duration) {
-	d, _ = time.ParseDuration(ToString(in))
+func ToDuration(in interface{}) time.Duration {
+	d, _ := ToDurationE(in)
+	return d
+}
 
+func ToDurationE(in interface{}) (time.Duration, error) {
+	return NewCaster().ToDurationE(in)
+}
+
+// ToDurationUnit is the same as ToDuration, but numeric values are
+// interpreted in unit instead of DefaultDurationUnit
+func ToDurationUnit(in interface{}, unit time.Duration) time.Duration {
+	d, _ := ToDurationUnitE(in, unit)
 	return d
 }
+
+func ToDurationUnitE(in interface{}, unit time.Duration) (time.Duration, error) {
+	c := NewCaster()
+	c.DurationUnit = unit
+
+	return c.ToDurationE(in)
+}
+
+func (c *Caster) ToDuration(in interface{}) time.Duration {
+	d, _ := c.ToDurationE(in)
+	return d
+}
+
+func (c *Caster) ToDurationE(in interface{}) (d time.Duration, err error) {
+	if castIn, ok, err := lookupCast[time.Duration](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	if isNil(in) {
+		return d, newCastError(in, durationType, ErrNil)
+	}
+
+	if value, ok, err := unwrapValuer(in); ok {
+		if err != nil {
+			return d, newCastError(in, durationType, err)
+		}
+
+		return c.ToDurationE(value)
+	}
+
+	switch v := in.(type) {
+	case time.Duration:
+		return v, nil
+
+	case *time.Duration:
+		return *v, nil
+	}
+
+	switch reflect.ValueOf(in).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := c.ToInt64E(in)
+		if err != nil {
+			return d, newCastError(in, durationType, errors.Unwrap(err))
+		}
+
+		if d, err = intToDuration(v, c.DurationUnit); err != nil {
+			return d, newCastError(in, durationType, err)
+		}
+
+		return d, nil
+
+	case reflect.Float32, reflect.Float64:
+		if d, err = floatToDuration(c.ToFloat64(in), c.DurationUnit); err != nil {
+			return d, newCastError(in, durationType, err)
+		}
+
+		return d, nil
+	}
+
+	v, err := c.ToStringE(in)
+	if err != nil {
+		return d, newCastError(in, durationType, ErrUnsupportedType)
+	}
+
+	if d, err = ParseDuration(v, c.DurationUnit); err != nil {
+		return d, newCastError(in, durationType, err)
+	}
+
+	return d, nil
+}
@@ -1,7 +1,11 @@
 package gotypes
 
 import (
+	"math"
+	"net"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -213,7 +217,7 @@ func Test_Float32One_ToString(t *testing.T) {
 
 	result := ToString(val)
 
-	assert.Equal(t, "1.000000", result)
+	assert.Equal(t, "1", result)
 }
 
 func Test_Float64One_ToString(t *testing.T) {
@@ -221,7 +225,7 @@ func Test_Float64One_ToString(t *testing.T) {
 
 	result := ToString(val)
 
-	assert.Equal(t, "1.000000", result)
+	assert.Equal(t, "1", result)
 }
 
 func Test_Interface_ToString(t *testing.T) {
@@ -879,3 +883,439 @@ func Test_StringPointerInterface_ToFloat32(t *testing.T) {
 
 	assert.Exactly(t, result, float32(1.2))
 }
+
+//
+// Error variants
+//
+
+func Test_StringNonNumeric_ToInt64EError(t *testing.T) {
+	val := "abc"
+
+	result, err := ToInt64E(val)
+
+	assert.Exactly(t, result, int64(0))
+	assert.Error(t, err)
+
+	castErr, ok := err.(*CastError)
+	assert.True(t, ok)
+	assert.Exactly(t, castErr.Value, interface{}("abc"))
+	assert.Equal(t, castErr.Type, stringType)
+	assert.Equal(t, castErr.Target, int64Type)
+}
+
+func Test_StringZero_ToInt64ENoError(t *testing.T) {
+	val := "0"
+
+	result, err := ToInt64E(val)
+
+	assert.Exactly(t, result, int64(0))
+	assert.NoError(t, err)
+}
+
+func Test_Struct_ToUint64EError(t *testing.T) {
+	val := struct{}{}
+
+	_, err := ToUint64E(val)
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func Test_StringNonNumeric_ToFloat64EError(t *testing.T) {
+	val := "1.5x"
+
+	result, err := ToFloat64E(val)
+
+	assert.Exactly(t, result, float64(0))
+	assert.Error(t, err)
+}
+
+func Test_Nil_ToStringEError(t *testing.T) {
+	result, err := ToStringE(nil)
+
+	assert.Exactly(t, result, "")
+	assert.EqualError(t, err, "gotypes: unable to cast <nil> of type nil to string: nil value")
+}
+
+func Test_StringNonEmpty_ToBoolENoError(t *testing.T) {
+	val := "123"
+
+	result, err := ToBoolE(val)
+
+	assert.True(t, result)
+	assert.NoError(t, err)
+}
+
+func Test_StringInvalid_ToTimeEError(t *testing.T) {
+	val := "not a time"
+
+	result, err := ToTimeE(val)
+
+	assert.True(t, result.IsZero())
+	assert.Error(t, err)
+}
+
+func Test_StringInvalid_ToDurationEError(t *testing.T) {
+	val := "1 hour"
+
+	result, err := ToDurationE(val)
+
+	assert.Exactly(t, result, time.Duration(0))
+	assert.Error(t, err)
+}
+
+func Test_StringValid_ToDurationENoError(t *testing.T) {
+	val := "1h30m"
+
+	result, err := ToDurationE(val)
+
+	assert.Exactly(t, result, 90*time.Minute)
+	assert.NoError(t, err)
+}
+
+//
+// Checked narrowing
+//
+
+func Test_IntOverflow_ToUint8Saturated(t *testing.T) {
+	val := 300
+
+	result, err := ToUint8E(val)
+
+	assert.Exactly(t, result, uint8(255))
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Exactly(t, ToUint8(val), uint8(255))
+}
+
+func Test_IntNegative_ToUint64Error(t *testing.T) {
+	val := -1
+
+	result, err := ToUint64E(val)
+
+	assert.Exactly(t, result, uint64(0))
+	assert.ErrorIs(t, err, ErrNegative)
+	assert.Exactly(t, ToUint64(val), uint64(0))
+}
+
+func Test_StringNegative_ToUint16Error(t *testing.T) {
+	val := "-5"
+
+	_, err := ToUint16E(val)
+
+	assert.ErrorIs(t, err, ErrNegative)
+	assert.Equal(t, err.(*CastError).Target, reflect.TypeOf(uint16(0)))
+}
+
+func Test_IntUnderflow_ToInt8Saturated(t *testing.T) {
+	val := int64(-1000)
+
+	result, err := ToInt8E(val)
+
+	assert.Exactly(t, result, int8(-128))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_Uint64Overflow_ToInt64Saturated(t *testing.T) {
+	val := uint64(math.MaxUint64)
+
+	result, err := ToInt64E(val)
+
+	assert.Exactly(t, result, int64(math.MaxInt64))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_FloatNaN_ToInt64Error(t *testing.T) {
+	val := math.NaN()
+
+	result, err := ToInt64E(val)
+
+	assert.Exactly(t, result, int64(0))
+	assert.ErrorIs(t, err, ErrNaN)
+}
+
+func Test_FloatInf_ToUint32Error(t *testing.T) {
+	val := math.Inf(1)
+
+	result, err := ToUint32E(val)
+
+	assert.Exactly(t, result, uint32(math.MaxUint32))
+	assert.ErrorIs(t, err, ErrNaN)
+}
+
+func Test_FloatInf_ToInt8Error(t *testing.T) {
+	result, err := ToInt8E(math.Inf(1))
+
+	assert.Exactly(t, result, int8(math.MaxInt8))
+	assert.ErrorIs(t, err, ErrNaN)
+
+	result, err = ToInt8E(math.Inf(-1))
+
+	assert.Exactly(t, result, int8(math.MinInt8))
+	assert.ErrorIs(t, err, ErrNaN)
+}
+
+func Test_FloatInf_ToUint8Error(t *testing.T) {
+	result, err := ToUint8E(math.Inf(1))
+
+	assert.Exactly(t, result, uint8(math.MaxUint8))
+	assert.ErrorIs(t, err, ErrNaN)
+}
+
+func Test_Float64Overflow_ToFloat32Saturated(t *testing.T) {
+	val := 1e300
+
+	result, err := ToFloat32E(val)
+
+	assert.Exactly(t, result, float32(math.MaxFloat32))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_IntInRange_ToInt16NoError(t *testing.T) {
+	val := 32767
+
+	result, err := ToInt16E(val)
+
+	assert.Exactly(t, result, int16(32767))
+	assert.NoError(t, err)
+}
+
+//
+// Named types
+//
+
+type namedInt int16
+
+type namedUint uint8
+
+type namedFloat float32
+
+type namedString string
+
+type namedBool bool
+
+type namedBytes []byte
+
+func Test_NamedInt_ToInt64(t *testing.T) {
+	val := namedInt(-12)
+
+	result, err := ToInt64E(val)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(-12))
+}
+
+func Test_NamedUint_ToString(t *testing.T) {
+	val := namedUint(7)
+
+	result := ToString(val)
+
+	assert.Exactly(t, result, "7")
+}
+
+func Test_NamedFloat_ToString(t *testing.T) {
+	val := namedFloat(1.5)
+
+	result := ToString(val)
+
+	assert.Exactly(t, result, "1.5")
+}
+
+func Test_NamedString_ToUint64(t *testing.T) {
+	val := namedString("15")
+
+	result := ToUint64(val)
+
+	assert.Exactly(t, result, uint64(15))
+}
+
+func Test_NamedString_ToString(t *testing.T) {
+	val := namedString("name")
+
+	result := ToString(val)
+
+	assert.Exactly(t, result, "name")
+}
+
+func Test_NamedBool_ToFloat64(t *testing.T) {
+	val := namedBool(true)
+
+	result := ToFloat64(val)
+
+	assert.Exactly(t, result, float64(1))
+}
+
+func Test_NamedBytes_ToBool(t *testing.T) {
+	val := namedBytes("true")
+
+	result, err := ToBoolE(val)
+
+	assert.NoError(t, err)
+	assert.True(t, result)
+}
+
+func Test_NamedStringPointer_ToInt(t *testing.T) {
+	val := namedString("3")
+
+	result := ToInt(&val)
+
+	assert.Exactly(t, result, 3)
+}
+
+//
+// Nil values
+//
+
+func Test_NilIntPointer_ToInt64(t *testing.T) {
+	var val *int
+
+	result, err := ToInt64E(val)
+
+	assert.Exactly(t, result, int64(0))
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_NilInterface_ToBool(t *testing.T) {
+	var val interface{}
+
+	result, err := ToBoolE(val)
+
+	assert.False(t, result)
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_NilStringerPointer_ToString(t *testing.T) {
+	var val *time.Time
+
+	result, err := ToStringE(val)
+
+	assert.Exactly(t, result, "")
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_DoublePointer_ToUint64(t *testing.T) {
+	val := 5
+	ptr := &val
+
+	result := ToUint64(&ptr)
+
+	assert.Exactly(t, result, uint64(5))
+}
+
+func Test_DoublePointerToNil_ToFloat64(t *testing.T) {
+	var ptr *float64
+
+	result, err := ToFloat64E(&ptr)
+
+	assert.Exactly(t, result, float64(0))
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_NilPointer_ToTime(t *testing.T) {
+	var val *string
+
+	result, err := ToTimeE(val)
+
+	assert.True(t, result.IsZero())
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+//
+// Integer parsing
+//
+
+func Test_StringHex_ToInt64(t *testing.T) {
+	val := "0x1F"
+
+	result, err := ToInt64E(val)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(31))
+}
+
+func Test_StringOctal_ToUint64(t *testing.T) {
+	val := "0o755"
+
+	result, err := ToUint64E(val)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, uint64(493))
+}
+
+func Test_StringBinary_ToInt(t *testing.T) {
+	val := "0b1010"
+
+	result := ToInt(val)
+
+	assert.Exactly(t, result, 10)
+}
+
+func Test_StringUnderscores_ToInt64(t *testing.T) {
+	val := "1_000_000"
+
+	result := ToInt64(val)
+
+	assert.Exactly(t, result, int64(1000000))
+}
+
+func Test_StringSignAndSpaces_ToInt64(t *testing.T) {
+	assert.Exactly(t, ToInt64("+42"), int64(42))
+	assert.Exactly(t, ToInt64(" 42 "), int64(42))
+	assert.Exactly(t, ToUint64(" 42\n"), uint64(42))
+}
+
+func Test_StringIntegralDecimal_ToInt64(t *testing.T) {
+	val := "42.0"
+
+	result, err := ToInt64E(val)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(42))
+}
+
+func Test_StringFractionalDecimal_ToUint64Error(t *testing.T) {
+	val := "42.5"
+
+	result, err := ToUint64E(val)
+
+	assert.Exactly(t, result, uint64(42))
+	assert.ErrorIs(t, err, ErrNotInteger)
+}
+
+func Test_StringNegativeDecimal_ToUint64Error(t *testing.T) {
+	val := "-3.0"
+
+	_, err := ToUint64E(val)
+
+	assert.ErrorIs(t, err, ErrNegative)
+}
+
+func Test_StringOutOfRange_ToInt64Error(t *testing.T) {
+	val := "9223372036854775808"
+
+	result, err := ToInt64E(val)
+
+	assert.Exactly(t, result, int64(math.MaxInt64))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_StringLeadingZeros_ToInt64(t *testing.T) {
+	assert.Exactly(t, ToInt64("010"), int64(10))
+	assert.Exactly(t, ToInt64("07"), int64(7))
+	assert.Exactly(t, ToInt64("-007"), int64(-7))
+	assert.Exactly(t, ToUint64("0042"), uint64(42))
+	assert.Exactly(t, ToUint64("000"), uint64(0))
+}
+
+//
+// Text marshalers
+//
+
+func Test_TextMarshaler_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(Level(2)), "info")
+	assert.Exactly(t, ToString(net.ParseIP("10.0.0.1")), "10.0.0.1")
+}
+
+func Test_TextMarshalerFailed_ToStringError(t *testing.T) {
+	_, err := ToStringE(Level(5))
+
+	assert.EqualError(t, err, "gotypes: unable to cast 5 of type gotypes.Level to string: unknown level")
+}
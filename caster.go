@@ -0,0 +1,52 @@
+package gotypes
+
+import (
+	"time"
+)
+
+// Caster holds the options of the casts. Its methods mirror the
+// package-level functions, which call them on NewCaster(), a Caster with
+// the package defaults (DefaultBoolVocabulary, DefaultFormatter,
+// DefaultTimeLayouts and so on). Create Casters with NewCaster, a zero
+// Caster has no vocabulary, layouts or duration unit
+type Caster struct {
+	// BoolVocabulary holds the words recognized by ToBool
+	BoolVocabulary *BoolVocabulary
+	// Formatter controls how ToString renders floats, times and collections
+	Formatter Formatter
+	// TimeLayouts are tried in order by ToTime
+	TimeLayouts *TimeLayouts
+	// Location of times parsed without a zone, nil means time.UTC
+	Location *time.Location
+	// DurationUnit is the unit of numbers cast by ToDuration
+	DurationUnit time.Duration
+	// UnixUnit is the unit of numbers cast by ToTime, zero detects it
+	// from the magnitude of the value
+	UnixUnit time.Duration
+	// Rounding converts fractional values to integers, the zero
+	// RoundDefault truncates floats and rejects decimal strings
+	Rounding Rounding
+	// Locale of strings cast to numbers, nil means the strconv syntax
+	Locale *Locale
+	// Registry is consulted before the built-in casts, nil disables it
+	Registry *Registry
+	// StrictComplex reports ErrImaginary when complex values with a
+	// non-zero imaginary part are cast to floats
+	StrictComplex bool
+	// BigFloatPrecision is the mantissa precision of ToBigFloat results
+	BigFloatPrecision uint
+}
+
+// NewCaster returns a Caster with the current package defaults. The
+// vocabulary, layouts and registry are shared with the defaults, assign
+// new ones to change them for this Caster only
+func NewCaster() *Caster {
+	return &Caster{
+		BoolVocabulary:    DefaultBoolVocabulary,
+		Formatter:         DefaultFormatter,
+		TimeLayouts:       DefaultTimeLayouts,
+		DurationUnit:      DefaultDurationUnit,
+		Registry:          DefaultRegistry,
+		BigFloatPrecision: BigFloatPrecision,
+	}
+}
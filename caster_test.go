@@ -0,0 +1,137 @@
+package gotypes
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCaster_HasDefaults(t *testing.T) {
+	c := NewCaster()
+
+	assert.Exactly(t, c.BoolVocabulary, DefaultBoolVocabulary)
+	assert.Exactly(t, c.TimeLayouts, DefaultTimeLayouts)
+	assert.Exactly(t, c.Registry, DefaultRegistry)
+	assert.Exactly(t, c.Rounding, RoundDefault)
+	assert.Exactly(t, c.DurationUnit, DefaultDurationUnit)
+	assert.Exactly(t, c.BigFloatPrecision, BigFloatPrecision)
+}
+
+func Test_Caster_ToString(t *testing.T) {
+	c := NewCaster()
+	c.Formatter.Float = FloatFormat{Verb: 'f', Precision: 2}
+
+	assert.Exactly(t, c.ToString(1.0/3), "0.33")
+	assert.Exactly(t, c.ToString([]float64{1, 2.5}), "1.00,2.50")
+	assert.Exactly(t, ToString(1.0/3), "0.3333333333333333")
+}
+
+func Test_Caster_ToBool(t *testing.T) {
+	c := NewCaster()
+	c.BoolVocabulary = NewBoolVocabulary([]string{"si"}, []string{"no"})
+	c.BoolVocabulary.SetStrict(true)
+
+	assert.True(t, c.ToBool("si"))
+	assert.True(t, c.ToBool([]byte("si")))
+
+	_, err := c.ToBoolE("yes")
+
+	assert.ErrorIs(t, err, ErrUnknownBool)
+	assert.True(t, ToBool("yes"))
+}
+
+func Test_Caster_ToInt64(t *testing.T) {
+	c := NewCaster()
+	c.Rounding = RoundHalfUp
+
+	assert.Exactly(t, c.ToInt64("2.5"), int64(3))
+	assert.Exactly(t, c.ToInt8(-2.5), int8(-3))
+	assert.Exactly(t, c.ToUint(2.5), uint(3))
+	assert.Exactly(t, ToInt64("2.5"), int64(2))
+}
+
+func Test_Caster_ToTime(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+
+	c := NewCaster()
+	c.TimeLayouts = NewTimeLayouts("02/01/2006")
+	c.Location = loc
+
+	assert.Exactly(t, c.ToTime("25/12/2020"), time.Date(2020, 12, 25, 0, 0, 0, 0, loc))
+
+	_, err := c.ToTimeE("2020-12-25")
+
+	assert.ErrorIs(t, err, ErrUnknownTimeLayout)
+}
+
+func Test_Caster_ToDuration(t *testing.T) {
+	c := NewCaster()
+	c.DurationUnit = time.Millisecond
+
+	assert.Exactly(t, c.ToDuration(1500), 1500*time.Millisecond)
+	assert.Exactly(t, ToDuration(2), 2*time.Second)
+}
+
+func Test_Caster_StrictComplex(t *testing.T) {
+	c := NewCaster()
+	c.StrictComplex = true
+
+	_, err := c.ToFloat64E(complex(1, 1))
+
+	assert.ErrorIs(t, err, ErrImaginary)
+	assert.Exactly(t, ToFloat64(complex(1, 1)), float64(1))
+}
+
+func Test_Caster_Registry(t *testing.T) {
+	c := NewCaster()
+	c.Registry = NewRegistry()
+	RegisterCast(c.Registry, parseMoney)
+	RegisterCast(c.Registry, func(m Money) (float64, error) {
+		return float64(m) / 100, nil
+	})
+
+	assert.Exactly(t, c.ToFloat32(Money(150)), float32(1.5))
+	assert.Exactly(t, ToFloat64(Money(150)), float64(150))
+
+	result, ok, err := c.Registry.Cast("$1", reflect.TypeOf(Money(0)))
+
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Exactly(t, result, Money(100))
+}
+
+func Test_CasterWithoutRegistry_ToString(t *testing.T) {
+	c := NewCaster()
+	c.Registry = nil
+
+	assert.Exactly(t, c.ToString(42), "42")
+}
+
+func Test_Caster_ToBigFloat(t *testing.T) {
+	c := NewCaster()
+	c.BigFloatPrecision = 24
+
+	assert.Exactly(t, c.ToBigFloat("0.1").Prec(), uint(24))
+	assert.Exactly(t, c.ToBigInt(math.MaxInt64).String(), "9223372036854775807")
+}
+
+func Test_Caster_Locale(t *testing.T) {
+	c := NewCaster()
+	c.Locale = LocaleDE
+
+	assert.Exactly(t, c.ToFloat64("1.234,5"), 1234.5)
+	assert.Exactly(t, c.ToInt64("1.000"), int64(1000))
+	assert.Exactly(t, c.ToUint8([]byte("12")), uint8(12))
+	assert.Exactly(t, ToInt64("1.000"), int64(1))
+}
+
+func Test_Caster_UnixUnit(t *testing.T) {
+	c := NewCaster()
+	c.UnixUnit = time.Millisecond
+
+	assert.Equal(t, c.ToTime(1000), time.Date(1970, time.January, 1, 0, 0, 1, 0, time.UTC))
+	assert.Equal(t, ToTime(1000), time.Date(1970, time.January, 1, 0, 16, 40, 0, time.UTC))
+}
@@ -0,0 +1,144 @@
+package gotypes
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	complex64Type  = reflect.TypeOf(complex64(0))
+	complex128Type = reflect.TypeOf(complex128(0))
+)
+
+func ToComplex64(in interface{}) complex64 {
+	castIn, _ := ToComplex64E(in)
+	return castIn
+}
+
+// ToComplex64E casts in to complex64, saturating parts which do not fit
+// into float32 and reporting ErrOverflow
+func ToComplex64E(in interface{}) (complex64, error) {
+	return NewCaster().ToComplex64E(in)
+}
+
+func (c *Caster) ToComplex64(in interface{}) complex64 {
+	castIn, _ := c.ToComplex64E(in)
+	return castIn
+}
+
+func (c *Caster) ToComplex64E(in interface{}) (complex64, error) {
+	if castIn, ok, err := lookupCast[complex64](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	castIn, err := c.ToComplex128E(in)
+	if err != nil {
+		return complex64(castIn), err
+	}
+
+	re, reOverflow := clampFloat32(real(castIn))
+	im, imOverflow := clampFloat32(imag(castIn))
+
+	if reOverflow || imOverflow {
+		return complex(re, im), newCastError(in, complex64Type, ErrOverflow)
+	}
+
+	return complex(re, im), nil
+}
+
+func ToComplex128(in interface{}) complex128 {
+	castIn, _ := ToComplex128E(in)
+	return castIn
+}
+
+// ToComplex128E casts in to complex128. Strings are parsed with
+// strconv.ParseComplex, e.g. "1+2i", "(1-2i)" or "3i", real numbers
+// become complex numbers with a zero imaginary part
+func ToComplex128E(in interface{}) (complex128, error) {
+	return NewCaster().ToComplex128E(in)
+}
+
+func (c *Caster) ToComplex128(in interface{}) complex128 {
+	castIn, _ := c.ToComplex128E(in)
+	return castIn
+}
+
+func (c *Caster) ToComplex128E(in interface{}) (complex128, error) {
+	if castIn, ok, err := lookupCast[complex128](c.Registry, in); ok {
+		return castIn, err
+	}
+
+	var castIn complex128
+
+	switch v := in.(type) {
+	case complex128:
+		castIn = v
+
+	case complex64:
+		castIn = complex128(v)
+
+	case string:
+		var err error
+		if castIn, err = strconv.ParseComplex(strings.TrimSpace(v), 128); err != nil {
+			return castIn, newCastError(in, complex128Type, err)
+		}
+
+	case []byte:
+		return c.ToComplex128E(string(v))
+
+	default:
+		if isNil(v) {
+			return castIn, newCastError(in, complex128Type, ErrNil)
+		}
+
+		if value, ok, err := unwrapValuer(v); ok {
+			if err != nil {
+				return castIn, newCastError(in, complex128Type, err)
+			}
+
+			return c.ToComplex128E(value)
+		}
+
+		if underlying, ok := toUnderlying(v); ok {
+			return c.ToComplex128E(underlying)
+		}
+
+		if reflect.ValueOf(v).Kind() == reflect.Ptr {
+			return c.ToComplex128E(reflect.Indirect(reflect.ValueOf(v)).Interface())
+		}
+
+		re, err := c.ToFloat64E(v)
+		if err != nil {
+			return complex(re, 0), newCastError(in, complex128Type, errors.Unwrap(err))
+		}
+
+		castIn = complex(re, 0)
+	}
+
+	return castIn, nil
+}
+
+// complexToFloat64 returns the real part of v, reporting ErrImaginary
+// in StrictComplex mode if the imaginary part is not zero
+func (c *Caster) complexToFloat64(v complex128) (float64, error) {
+	if c.StrictComplex && imag(v) != 0 {
+		return real(v), ErrImaginary
+	}
+
+	return real(v), nil
+}
+
+func clampFloat32(v float64) (float32, bool) {
+	switch {
+	case v > math.MaxFloat32 && !math.IsInf(v, 1):
+		return math.MaxFloat32, true
+
+	case v < -math.MaxFloat32 && !math.IsInf(v, -1):
+		return -math.MaxFloat32, true
+	}
+
+	return float32(v), false
+}
@@ -0,0 +1,99 @@
+package gotypes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Strings_ToComplex128(t *testing.T) {
+	cases := map[string]complex128{
+		"1+2i":    complex(1, 2),
+		" (1-2i)": complex(1, -2),
+		"3i":      complex(0, 3),
+		"-1.5":    complex(-1.5, 0),
+	}
+
+	for in, out := range cases {
+		result, err := ToComplex128E(in)
+
+		assert.NoError(t, err)
+		assert.Exactly(t, result, out)
+	}
+}
+
+func Test_InvalidString_ToComplex128Error(t *testing.T) {
+	_, err := ToComplex128E("1+2j")
+
+	assert.Error(t, err)
+}
+
+func Test_Numbers_ToComplex128(t *testing.T) {
+	assert.Exactly(t, ToComplex128(int8(-3)), complex(-3, 0))
+	assert.Exactly(t, ToComplex128(uint64(7)), complex(7, 0))
+	assert.Exactly(t, ToComplex128(float32(0.5)), complex(0.5, 0))
+	assert.Exactly(t, ToComplex128(true), complex(1, 0))
+	assert.Exactly(t, ToComplex128(complex64(complex(1, 2))), complex(1, 2))
+}
+
+func Test_NamedComplex_ToComplex128(t *testing.T) {
+	type Impedance complex128
+
+	assert.Exactly(t, ToComplex128(Impedance(complex(4, 5))), complex(4, 5))
+}
+
+func Test_Nil_ToComplex128Error(t *testing.T) {
+	var in *complex128
+
+	_, err := ToComplex128E(in)
+
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_UnsupportedType_ToComplex128Error(t *testing.T) {
+	_, err := ToComplex128E(struct{}{})
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func Test_Overflow_ToComplex64Error(t *testing.T) {
+	result, err := ToComplex64E(complex(1e300, 1))
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Exactly(t, result, complex64(complex(math.MaxFloat32, 1)))
+}
+
+func Test_Complex_ToFloat64(t *testing.T) {
+	result, err := ToFloat64E(complex(1.5, 2))
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 1.5)
+}
+
+func Test_ComplexStrict_ToFloat64Error(t *testing.T) {
+	c := NewCaster()
+	c.StrictComplex = true
+
+	result, err := c.ToFloat64E(complex64(complex(1.5, 2)))
+
+	assert.ErrorIs(t, err, ErrImaginary)
+	assert.Exactly(t, result, 1.5)
+
+	result, err = c.ToFloat64E(complex(1.5, 0))
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 1.5)
+}
+
+func Test_Complex_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(complex(1, 2)), "(1+2i)")
+	assert.Exactly(t, ToString(complex64(complex(0.1, -0.5))), "(0.1-0.5i)")
+	assert.Exactly(t, ToString(complex(math.Inf(1), 0)), "(+Inf+0i)")
+}
+
+func Test_ComplexString_RoundTrip(t *testing.T) {
+	in := complex(1.25, -3e-9)
+
+	assert.Exactly(t, ToComplex128(ToString(in)), in)
+}
@@ -0,0 +1,116 @@
+package gotypes
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Day  = 24 * time.Hour
+	Week = 7 * Day
+
+	// ISO 8601 years and months have no fixed length, they are
+	// approximated as 365 and 30 days
+	isoYear  = 365 * Day
+	isoMonth = 30 * Day
+)
+
+// DefaultDurationUnit is the unit of numeric values converted by
+// ToDuration and ToDurationE
+var DefaultDurationUnit = time.Second
+
+var (
+	durationUnits = map[string]time.Duration{
+		"ns": time.Nanosecond,
+		"us": time.Microsecond,
+		"µs": time.Microsecond, // U+00B5 micro sign
+		"μs": time.Microsecond, // U+03BC Greek letter mu
+		"ms": time.Millisecond,
+		"s":  time.Second,
+		"m":  time.Minute,
+		"h":  time.Hour,
+		"d":  Day,
+		"w":  Week,
+	}
+
+	durationRegexp     = regexp.MustCompile(`^([-+]?)((?:(?:\d+(?:\.\d*)?|\.\d+)(?:ns|us|µs|μs|ms|s|m|h|d|w))+)$`)
+	durationPartRegexp = regexp.MustCompile(`(\d+(?:\.\d*)?|\.\d+)(ns|us|µs|μs|ms|s|m|h|d|w)`)
+
+	isoNumber         = `(\d+(?:[.,]\d+)?)`
+	isoDurationRegexp = regexp.MustCompile(`^([-+]?)P(?:` + isoNumber + `Y)?(?:` + isoNumber + `M)?(?:` + isoNumber + `W)?(?:` + isoNumber + `D)?` +
+		`(?:T(?:` + isoNumber + `H)?(?:` + isoNumber + `M)?(?:` + isoNumber + `S)?)?$`)
+	isoDurationUnits = []time.Duration{isoYear, isoMonth, Week, Day, time.Hour, time.Minute, time.Second}
+)
+
+// ParseDuration extends time.ParseDuration with days ("3d"), weeks ("2w"),
+// ISO 8601 durations ("PT1H30M", "P2D") and bare numbers interpreted
+// in unit
+func ParseDuration(s string, unit time.Duration) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return floatToDuration(f, unit)
+	}
+
+	if parts := durationRegexp.FindStringSubmatch(s); parts != nil {
+		var total float64
+
+		for _, part := range durationPartRegexp.FindAllStringSubmatch(parts[2], -1) {
+			f, _ := strconv.ParseFloat(part[1], 64)
+			total += f * float64(durationUnits[part[2]])
+		}
+
+		return signedDuration(parts[1], total)
+	}
+
+	if parts := isoDurationRegexp.FindStringSubmatch(s); parts != nil && s[len(s)-1] != 'P' && s[len(s)-1] != 'T' {
+		var total float64
+
+		for i, unit := range isoDurationUnits {
+			if parts[i+2] == "" {
+				continue
+			}
+
+			f, _ := strconv.ParseFloat(strings.Replace(parts[i+2], ",", ".", 1), 64)
+			total += f * float64(unit)
+		}
+
+		return signedDuration(parts[1], total)
+	}
+
+	return 0, ErrInvalidDuration
+}
+
+func signedDuration(sign string, total float64) (time.Duration, error) {
+	if sign == "-" {
+		total = -total
+	}
+
+	return floatToDuration(total, time.Nanosecond)
+}
+
+func floatToDuration(v float64, unit time.Duration) (time.Duration, error) {
+	castIn, err := floatToInt64(math.Round(v * float64(unit)))
+	return time.Duration(castIn), err
+}
+
+func intToDuration(v int64, unit time.Duration) (time.Duration, error) {
+	d := time.Duration(v) * unit
+
+	if unit != 0 && d/unit != time.Duration(v) {
+		if (v < 0) != (unit < 0) {
+			return math.MinInt64, ErrOverflow
+		}
+
+		return math.MaxInt64, ErrOverflow
+	}
+
+	return d, nil
+}
@@ -0,0 +1,68 @@
+package gotypes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StringISO8601_ToDuration(t *testing.T) {
+	result, err := ToDurationE("PT1H30M")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 90*time.Minute)
+}
+
+func Test_StringISO8601Days_ToDuration(t *testing.T) {
+	assert.Exactly(t, ToDuration("P2D"), 48*time.Hour)
+	assert.Exactly(t, ToDuration("P1W"), Week)
+	assert.Exactly(t, ToDuration("P1DT0,5S"), Day+500*time.Millisecond)
+	assert.Exactly(t, ToDuration("-PT10S"), -10*time.Second)
+}
+
+func Test_StringISO8601Empty_ToDurationError(t *testing.T) {
+	for _, val := range []string{"P", "PT", "P1H"} {
+		_, err := ToDurationE(val)
+
+		assert.ErrorIs(t, err, ErrInvalidDuration, val)
+	}
+}
+
+func Test_StringDaysAndWeeks_ToDuration(t *testing.T) {
+	assert.Exactly(t, ToDuration("3d"), 72*time.Hour)
+	assert.Exactly(t, ToDuration("2w"), 14*Day)
+	assert.Exactly(t, ToDuration("1d12h30m"), 36*time.Hour+30*time.Minute)
+	assert.Exactly(t, ToDuration("1.5d"), 36*time.Hour)
+	assert.Exactly(t, ToDuration("-1w"), -Week)
+}
+
+func Test_IntSeconds_ToDuration(t *testing.T) {
+	result, err := ToDurationE(30)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 30*time.Second)
+}
+
+func Test_FloatSeconds_ToDuration(t *testing.T) {
+	assert.Exactly(t, ToDuration(1.5), 1500*time.Millisecond)
+	assert.Exactly(t, ToDuration("1.5"), 1500*time.Millisecond)
+}
+
+func Test_IntCustomUnit_ToDuration(t *testing.T) {
+	assert.Exactly(t, ToDurationUnit(250, time.Millisecond), 250*time.Millisecond)
+	assert.Exactly(t, ToDurationUnit("2", time.Minute), 2*time.Minute)
+}
+
+func Test_Duration_ToDuration(t *testing.T) {
+	val := 5 * time.Nanosecond
+
+	assert.Exactly(t, ToDuration(val), val)
+	assert.Exactly(t, ToDuration(&val), val)
+}
+
+func Test_IntOverflow_ToDurationError(t *testing.T) {
+	_, err := ToDurationUnitE(int64(1)<<62, time.Hour)
+
+	assert.ErrorIs(t, err, ErrOverflow)
+}
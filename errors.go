@@ -0,0 +1,62 @@
+package gotypes
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	ErrUnsupportedType   = errors.New("unsupported type")
+	ErrNil               = errors.New("nil value")
+	ErrOverflow          = errors.New("value out of range")
+	ErrNegative          = errors.New("negative value for unsigned type")
+	ErrNaN               = errors.New("NaN or Inf value for integer type")
+	ErrNotInteger        = errors.New("value has a fractional part")
+	ErrUnknownBool       = errors.New("unknown boolean word")
+	ErrUnknownTimeLayout = errors.New("no matching time layout")
+	ErrInvalidDuration   = errors.New("invalid duration")
+	ErrInvalidNumber     = errors.New("invalid number")
+	ErrImaginary         = errors.New("complex value has an imaginary part")
+)
+
+// isRangeError reports whether err means that a value does not fit
+// the target type
+func isRangeError(err error) bool {
+	return errors.Is(err, ErrOverflow) || errors.Is(err, ErrNegative) || errors.Is(err, ErrNaN)
+}
+
+// CastError describes a failed conversion of Value to the Target type
+type CastError struct {
+	Value  interface{}
+	Type   reflect.Type
+	Target reflect.Type
+	Err    error
+}
+
+func newCastError(in interface{}, target reflect.Type, err error) *CastError {
+	return &CastError{
+		Value:  in,
+		Type:   reflect.TypeOf(in),
+		Target: target,
+		Err:    err,
+	}
+}
+
+func (e *CastError) Error() string {
+	from := "nil"
+	if e.Type != nil {
+		from = e.Type.String()
+	}
+
+	msg := fmt.Sprintf("gotypes: unable to cast %#v of type %s to %s", e.Value, from, e.Target)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+func (e *CastError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,166 @@
+package gotypes
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// CollectionJoin renders slices and maps as a list of elements joined
+	// by the separator, map entries are written as key=value sorted by key
+	CollectionJoin CollectionFormat = iota
+	// CollectionJSON renders slices and maps as JSON
+	CollectionJSON
+)
+
+// DefaultFormatter is used by ToString and ToStringE
+var DefaultFormatter = Formatter{
+	TimeLayout: time.RFC3339,
+	Collection: CollectionJoin,
+	Separator:  ",",
+}
+
+type CollectionFormat int
+
+// FloatFormat controls how floats are converted to strings. Verb is one
+// of the strconv.FormatFloat formats ('f', 'e', 'g', ...), zero means
+// 'f' switching to 'e' for very small and very large numbers, the same
+// way encoding/json does. A Precision of -1 gives the shortest string
+// that round-trips, so does the zero FloatFormat
+type FloatFormat struct {
+	Verb      byte
+	Precision int
+}
+
+// Formatter controls how ToString renders values that have no single
+// obvious string form. An empty TimeLayout means time.RFC3339, an empty
+// Separator means ","
+type Formatter struct {
+	Float      FloatFormat
+	TimeLayout string
+	Collection CollectionFormat
+	Separator  string
+}
+
+func (f FloatFormat) FormatFloat(v float64, bitSize int) string {
+	if f == (FloatFormat{}) {
+		f.Precision = -1
+	}
+
+	verb := f.Verb
+
+	if verb == 0 {
+		verb = 'f'
+
+		if abs := math.Abs(v); abs != 0 {
+			if bitSize == 32 {
+				abs = float64(float32(abs))
+			}
+
+			if abs < 1e-6 || abs >= 1e21 {
+				verb = 'e'
+			}
+		}
+	}
+
+	return strconv.FormatFloat(v, verb, f.Precision, bitSize)
+}
+
+// FormatComplex formats both parts of v like FormatFloat, in the
+// "(1+2i)" form of strconv.FormatComplex. bitSize is 64 for complex64
+// and 128 for complex128
+func (f FloatFormat) FormatComplex(v complex128, bitSize int) string {
+	im := f.FormatFloat(imag(v), bitSize/2)
+	if im[0] != '+' && im[0] != '-' {
+		im = "+" + im
+	}
+
+	return "(" + f.FormatFloat(real(v), bitSize/2) + im + "i)"
+}
+
+func (f Formatter) FormatTime(t time.Time) string {
+	layout := f.TimeLayout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	return t.Format(layout)
+}
+
+func (f Formatter) ToString(in interface{}) string {
+	castIn, _ := f.ToStringE(in)
+	return castIn
+}
+
+func (f Formatter) ToStringE(in interface{}) (string, error) {
+	c := NewCaster()
+	c.Formatter = f
+
+	return c.ToStringE(in)
+}
+
+func (c *Caster) formatCollection(in interface{}) (string, error) {
+	if c.Formatter.Collection == CollectionJSON {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return "", newCastError(in, stringType, err)
+		}
+
+		return string(b), nil
+	}
+
+	separator := c.Formatter.Separator
+	if separator == "" {
+		separator = ","
+	}
+
+	value := reflect.ValueOf(in)
+	parts := make([]string, 0, value.Len())
+
+	switch value.Kind() {
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			k, err := c.ToStringE(key.Interface())
+			if err != nil {
+				return "", err
+			}
+
+			v, err := c.formatElement(value.MapIndex(key))
+			if err != nil {
+				return "", err
+			}
+
+			parts = append(parts, k+"="+v)
+		}
+
+		sort.Strings(parts)
+
+	default:
+		for i := 0; i < value.Len(); i++ {
+			v, err := c.formatElement(value.Index(i))
+			if err != nil {
+				return "", err
+			}
+
+			parts = append(parts, v)
+		}
+	}
+
+	return strings.Join(parts, separator), nil
+}
+
+// formatElement renders an element of a collection, nil elements are
+// rendered as an empty string
+func (c *Caster) formatElement(value reflect.Value) (string, error) {
+	element := value.Interface()
+	if isNil(element) {
+		return "", nil
+	}
+
+	return c.ToStringE(element)
+}
@@ -0,0 +1,122 @@
+package gotypes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FloatShortest_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(0.1), "0.1")
+	assert.Exactly(t, ToString(float32(0.1)), "0.1")
+	assert.Exactly(t, ToString(123456789.125), "123456789.125")
+	assert.Exactly(t, ToString(-2.5), "-2.5")
+}
+
+func Test_FloatLargeAndSmall_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(1e20), "100000000000000000000")
+	assert.Exactly(t, ToString(1e21), "1e+21")
+	assert.Exactly(t, ToString(1e-7), "1e-07")
+	assert.Exactly(t, ToString(float32(0)), "0")
+}
+
+func Test_FloatZeroFormat_ToString(t *testing.T) {
+	format := Formatter{}
+
+	assert.Exactly(t, format.ToString(1.5), "1.5")
+	assert.Exactly(t, FloatFormat{}.FormatFloat(0.1, 64), "0.1")
+	assert.Exactly(t, FloatFormat{Verb: 'f'}.FormatFloat(1.5, 64), "2")
+}
+
+func Test_FloatFixedPrecision_ToString(t *testing.T) {
+	format := Formatter{Float: FloatFormat{Verb: 'f', Precision: 2}}
+
+	assert.Exactly(t, format.ToString(0.125), "0.12")
+	assert.Exactly(t, format.ToString(1e20), "100000000000000000000.00")
+}
+
+func Test_FloatExponent_ToString(t *testing.T) {
+	format := Formatter{Float: FloatFormat{Verb: 'e', Precision: 3}}
+
+	assert.Exactly(t, format.ToString(1234.5678), "1.235e+03")
+}
+
+func Test_FloatGeneral_ToString(t *testing.T) {
+	format := Formatter{Float: FloatFormat{Verb: 'g', Precision: -1}}
+
+	assert.Exactly(t, format.ToString(1e20), "1e+20")
+	assert.Exactly(t, format.ToString(0.5), "0.5")
+}
+
+func Test_FloatFormatKeepsOtherTypes_ToString(t *testing.T) {
+	format := Formatter{Float: FloatFormat{Verb: 'f', Precision: 2}}
+
+	assert.Exactly(t, format.ToString(10), "10")
+	assert.Exactly(t, format.ToString("abc"), "abc")
+}
+
+type formatError struct{}
+
+func (formatError) Error() string {
+	return "format error"
+}
+
+func Test_Error_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(errors.New("failed")), "failed")
+	assert.Exactly(t, ToString(formatError{}), "format error")
+	assert.Exactly(t, ToString(&formatError{}), "format error")
+}
+
+func Test_Time_ToString(t *testing.T) {
+	val := time.Date(2016, time.August, 19, 18, 55, 0, 0, time.UTC)
+
+	assert.Exactly(t, ToString(val), "2016-08-19T18:55:00Z")
+	assert.Exactly(t, ToString(&val), "2016-08-19T18:55:00Z")
+}
+
+func Test_TimeCustomLayout_ToString(t *testing.T) {
+	val := time.Date(2016, time.August, 19, 18, 55, 0, 0, time.UTC)
+	format := DefaultFormatter
+	format.TimeLayout = DotDateTimeLayout
+
+	assert.Exactly(t, format.ToString(val), "19.08.2016 18:55:00")
+}
+
+func Test_Duration_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(90*time.Minute), "1h30m0s")
+}
+
+func Test_Slice_ToString(t *testing.T) {
+	assert.Exactly(t, ToString([]int{1, 2, 3}), "1,2,3")
+	assert.Exactly(t, ToString([2]float64{0.5, 1}), "0.5,1")
+	assert.Exactly(t, ToString([]interface{}{"a", nil, true}), "a,,true")
+}
+
+func Test_Map_ToString(t *testing.T) {
+	val := map[string]int{"b": 2, "a": 1}
+
+	assert.Exactly(t, ToString(val), "a=1,b=2")
+}
+
+func Test_SliceCustomSeparator_ToString(t *testing.T) {
+	format := DefaultFormatter
+	format.Separator = "; "
+
+	assert.Exactly(t, format.ToString([]string{"a", "b"}), "a; b")
+}
+
+func Test_CollectionJSON_ToString(t *testing.T) {
+	format := DefaultFormatter
+	format.Collection = CollectionJSON
+
+	assert.Exactly(t, format.ToString([]int{1, 2}), "[1,2]")
+	assert.Exactly(t, format.ToString(map[string]interface{}{"a": "b"}), `{"a":"b"}`)
+}
+
+func Test_SliceWithUnsupportedElement_ToStringError(t *testing.T) {
+	_, err := ToStringE([]interface{}{1, struct{}{}})
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
@@ -0,0 +1,174 @@
+package gotypes
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// To casts in to T. Basic kinds (including named types such as
+// `type Status int`) are handled by the ToXxxE functions, time.Time and
+// time.Duration by ToTimeE and ToDurationE, while structs, slices, maps
+// and pointers are filled by a Converter
+func To[T any](in interface{}) (T, error) {
+	var out T
+
+	if v, ok := in.(T); ok {
+		return v, nil
+	}
+
+	if castIn, ok, err := lookupCast[T](DefaultRegistry, in); ok {
+		return castIn, err
+	}
+
+	result := reflect.ValueOf(&out).Elem()
+	target := result.Type()
+
+	var (
+		value interface{}
+		err   error
+	)
+
+	switch target {
+	case timeType:
+		value, err = ToTimeE(in)
+
+	case durationType:
+		value, err = ToDurationE(in)
+
+	default:
+		switch target.Kind() {
+		case reflect.Bool:
+			value, err = ToBoolE(in)
+
+		case reflect.String:
+			value, err = ToStringE(in)
+
+		case reflect.Int:
+			value, err = ToIntE(in)
+
+		case reflect.Int8:
+			value, err = ToInt8E(in)
+
+		case reflect.Int16:
+			value, err = ToInt16E(in)
+
+		case reflect.Int32:
+			value, err = ToInt32E(in)
+
+		case reflect.Int64:
+			value, err = ToInt64E(in)
+
+		case reflect.Uint:
+			value, err = ToUintE(in)
+
+		case reflect.Uint8:
+			value, err = ToUint8E(in)
+
+		case reflect.Uint16:
+			value, err = ToUint16E(in)
+
+		case reflect.Uint32:
+			value, err = ToUint32E(in)
+
+		case reflect.Uint64:
+			value, err = ToUint64E(in)
+
+		case reflect.Float32:
+			value, err = ToFloat32E(in)
+
+		case reflect.Float64:
+			value, err = ToFloat64E(in)
+
+		case reflect.Complex64:
+			value, err = ToComplex64E(in)
+
+		case reflect.Complex128:
+			value, err = ToComplex128E(in)
+
+		case reflect.Struct, reflect.Slice, reflect.Map, reflect.Ptr:
+			if !feedsComposite(in, target) {
+				return out, newCastError(in, target, ErrUnsupportedType)
+			}
+
+			return out, convertTo(in, &out)
+
+		default:
+			return out, newCastError(in, target, ErrUnsupportedType)
+		}
+	}
+
+	result.Set(reflect.ValueOf(value).Convert(target))
+
+	return out, err
+}
+
+func convertTo(in interface{}, out interface{}) error {
+	converter := NewConverter(in, out)
+
+	errs := converter.GetFieldErrors()
+	if len(errs) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(errs))
+	for path := range errs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return fmt.Errorf("gotypes: field %q: %w", paths[0], errs[paths[0]])
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	convertibleType     = reflect.TypeOf((*Convertible)(nil)).Elem()
+)
+
+// feedsComposite reports whether a Converter can fill a struct, slice or
+// map of the target type from in. Types which fill themselves, such as
+// unmarshalers, time.Time or sql.Null*, accept any input
+func feedsComposite(in interface{}, target reflect.Type) bool {
+	for target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+
+	if value, ok, err := unwrapValuer(in); ok && err == nil {
+		in = value
+	}
+
+	value, ok := indirect(in)
+	if !ok {
+		return true
+	}
+
+	switch target {
+	case timeType, bigIntType, bigFloatType, bigRatType:
+		return true
+	}
+
+	ptr := reflect.PtrTo(target)
+	if isNullType(target) || ptr.Implements(textUnmarshalerType) ||
+		ptr.Implements(jsonUnmarshalerType) || ptr.Implements(convertibleType) {
+		return true
+	}
+
+	if _, ok := DefaultRegistry.Lookup(reflect.TypeOf(value), target); ok {
+		return true
+	}
+
+	kind := reflect.ValueOf(value).Kind()
+
+	switch target.Kind() {
+	case reflect.Slice:
+		return kind == reflect.Slice
+
+	case reflect.Map, reflect.Struct:
+		return kind == reflect.Map || kind == reflect.Struct
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+package gotypes
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type GenericStatus int
+
+type GenericName string
+
+type GenericStruct struct {
+	ID   int64
+	Name string `json:"name"`
+}
+
+func Test_StringToInt_ResultIsValid(t *testing.T) {
+	result, err := To[int]("42")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 42)
+}
+
+func Test_StringToComplexGeneric_ResultIsValid(t *testing.T) {
+	result, err := To[complex128]("1+2i")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, complex(1, 2))
+
+	result64, err := To[complex64](2.5)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result64, complex64(complex(2.5, 0)))
+}
+
+func Test_StringToNamedInt_ResultIsValid(t *testing.T) {
+	result, err := To[GenericStatus]("3")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, GenericStatus(3))
+}
+
+func Test_IntToNamedString_ResultIsValid(t *testing.T) {
+	result, err := To[GenericName](10)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, GenericName("10"))
+}
+
+func Test_IntOverflowToUint8_ResultIsNotValid(t *testing.T) {
+	result, err := To[uint8](256)
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Exactly(t, result, uint8(255))
+}
+
+func Test_StringToDuration_ResultIsValid(t *testing.T) {
+	result, err := To[time.Duration]("2m")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 2*time.Minute)
+}
+
+func Test_StringToTimeGeneric_ResultIsValid(t *testing.T) {
+	result, err := To[time.Time]("19.08.2016 18:55:00")
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2016, time.August, 19, 18, 55, 0, 0, time.UTC))
+}
+
+func Test_MapToStructGeneric_ResultIsValid(t *testing.T) {
+	result, err := To[GenericStruct](map[string]interface{}{
+		"ID":   "7",
+		"name": "seven",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, GenericStruct{ID: 7, Name: "seven"})
+}
+
+func Test_SliceToSliceGeneric_ResultIsNotValid(t *testing.T) {
+	result, err := To[[]int8]([]interface{}{"1", 1000})
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Equal(t, result, []int8{1, 127})
+}
+
+func Test_UnsupportedKindGeneric_ResultIsNotValid(t *testing.T) {
+	_, err := To[chan int]("1")
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func Test_ScalarToCompositeGeneric_ResultIsNotValid(t *testing.T) {
+	_, err := To[[]int]("abc")
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+
+	_, err = To[GenericStruct](42)
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+
+	_, err = To[map[string]int]([]int{1})
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func Test_StringToUnmarshalerGeneric_ResultIsValid(t *testing.T) {
+	result, err := To[net.IP]("10.0.0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, result.String(), "10.0.0.1")
+}
@@ -3,7 +3,11 @@ package gotypes
 //go:generate goimports -w ./
 
 import (
+	"encoding"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"regexp"
 	"strings"
@@ -13,19 +17,38 @@ import (
 
 const (
 	FieldsSeparator = "."
+	OptionsTag      = "gotypes"
+
+	// ByteSizeOption of the OptionsTag makes numeric fields parse strings
+	// with ParseByteSize, e.g. `gotypes:"bytesize"`
+	ByteSizeOption = "bytesize"
+	// QuantityOption of the OptionsTag makes numeric fields parse strings
+	// with ParseQuantity, e.g. `gotypes:"quantity"`
+	QuantityOption = "quantity"
 )
 
 var (
-	timeType = reflect.TypeOf(time.Time{})
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
 )
 
+// Convertible is implemented by types which build themselves from loose
+// input. Converter calls ConvertFrom on the output field, by pointer,
+// instead of converting the input by its kind
+type Convertible interface {
+	ConvertFrom(input interface{}) error
+}
+
 type Converter struct {
 	input                 interface{}
 	output                interface{}
 	allowZeroFields       map[string]bool
 	allowZeroFieldsByMask []*regexp.Regexp
 	setValueFields        map[string]bool
+	wholeValueFields      map[string]bool
 	invalidFields         []string
+	fieldErrors           map[string]error
+	caster                *Caster
 	calculateOnce         sync.Once
 	validateOnce          sync.Once
 }
@@ -37,10 +60,74 @@ func NewConverter(input interface{}, output interface{}) *Converter {
 		allowZeroFields:       map[string]bool{},
 		allowZeroFieldsByMask: []*regexp.Regexp{},
 		setValueFields:        map[string]bool{},
+		wholeValueFields:      map[string]bool{},
 		invalidFields:         []string{},
+		fieldErrors:           map[string]error{},
+		caster:                NewCaster(),
 	}
 }
 
+// SetCaster sets the options of the casts used to fill the fields. The
+// caster is copied, so the other setters do not modify it
+func (c *Converter) SetCaster(caster *Caster) *Converter {
+	copied := *caster
+	c.caster = &copied
+
+	return c
+}
+
+// SetTimeLayouts sets the layouts used to fill time.Time fields
+func (c *Converter) SetTimeLayouts(layouts *TimeLayouts) *Converter {
+	c.caster.TimeLayouts = layouts
+	return c
+}
+
+// SetLocation sets the time zone for time.Time fields filled from
+// values without an explicit zone
+func (c *Converter) SetLocation(loc *time.Location) *Converter {
+	c.caster.Location = loc
+	return c
+}
+
+// SetDurationUnit sets the unit of numeric values filled into
+// time.Duration fields
+func (c *Converter) SetDurationUnit(unit time.Duration) *Converter {
+	c.caster.DurationUnit = unit
+	return c
+}
+
+// SetFormatter sets the formatter of values written into string fields
+func (c *Converter) SetFormatter(formatter Formatter) *Converter {
+	c.caster.Formatter = formatter
+	return c
+}
+
+// SetFloatFormat sets the format of floats written into string fields
+func (c *Converter) SetFloatFormat(format FloatFormat) *Converter {
+	c.caster.Formatter.Float = format
+	return c
+}
+
+// SetLocale sets the locale used to parse strings into numeric fields
+func (c *Converter) SetLocale(locale *Locale) *Converter {
+	c.caster.Locale = locale
+	return c
+}
+
+// SetRounding sets the strategy of rounding fractional values filled
+// into integer fields
+func (c *Converter) SetRounding(rounding Rounding) *Converter {
+	c.caster.Rounding = rounding
+	return c
+}
+
+// SetRegistry sets the registry of conversions consulted before the
+// built-in ones, instead of DefaultRegistry. nil disables the registry
+func (c *Converter) SetRegistry(registry *Registry) *Converter {
+	c.caster.Registry = registry
+	return c
+}
+
 func (c *Converter) Valid() bool {
 	c.calculateOnce.Do(c.calculation)
 	c.validateOnce.Do(c.validate)
@@ -53,6 +140,11 @@ func (c *Converter) GetInvalidFields() []string {
 	return c.invalidFields
 }
 
+func (c *Converter) GetFieldErrors() map[string]error {
+	c.calculateOnce.Do(c.calculation)
+	return c.fieldErrors
+}
+
 func (c *Converter) GetInput() interface{} {
 	return c.input
 }
@@ -89,6 +181,15 @@ func (c *Converter) getName(field reflect.StructField) string {
 	return name
 }
 
+func (c *Converter) getOptions(field reflect.StructField) tagOptions {
+	tag := field.Tag.Get(OptionsTag)
+	if tag == "" {
+		return nil
+	}
+
+	return strings.Split(tag, ",")
+}
+
 func (c *Converter) isIgnoreField(field reflect.StructField) bool {
 	tag := field.Tag.Get("json")
 	if tag == "" {
@@ -100,14 +201,111 @@ func (c *Converter) isIgnoreField(field reflect.StructField) bool {
 }
 
 func (c *Converter) calculation() {
-	in := reflect.Indirect(reflect.ValueOf(c.input)).Interface()
+	in, _ := indirect(c.input)
 	out := reflect.Indirect(reflect.ValueOf(c.output))
 
 	c.findAllowZeroFields(out, "")
-	c.fillOutput(out, in, "")
+	c.fillOutput(out, in, "", nil)
 }
 
-func (c *Converter) fillOutput(output reflect.Value, input interface{}, path string) {
+func (c *Converter) fillOutput(output reflect.Value, input interface{}, path string, options tagOptions) {
+	// sql.Null* outputs store NULL explicitly instead of being left untouched
+	if output.IsValid() && isNullType(output.Type()) {
+		c.fillNull(output, input, path, options)
+		return
+	}
+
+	// nil input means "no value", the output is left untouched
+	if isNil(input) {
+		return
+	}
+
+	if c.fillRegistered(output, input, path) {
+		return
+	}
+
+	// driver.Valuer inputs such as sql.NullString are unwrapped, NULL
+	// means "no value" as well
+	if output.IsValid() && output.Type() != reflect.TypeOf(input) {
+		if value, ok, err := unwrapValuer(input); ok {
+			if errors.Is(err, ErrNil) {
+				return
+			}
+
+			if err != nil {
+				c.setFieldError(path, err)
+				return
+			}
+
+			input = value
+		}
+	}
+
+	if c.fillConvertible(output, input, path) {
+		return
+	}
+
+	// Custom types
+	if output.IsValid() {
+		switch output.Type() {
+		case timeType:
+			v, err := c.caster.ToTimeE(input)
+
+			c.setValueFields[path] = true
+			output.Set(reflect.ValueOf(v))
+			c.setCastError(path, err)
+
+			return
+
+		case durationType:
+			v, err := c.caster.ToDurationE(input)
+			c.setInt(output, int64(v), err, path)
+
+			return
+
+		case bigIntType:
+			v, err := c.caster.ToBigIntE(input)
+			c.setBig(output, v, err, path)
+
+			return
+
+		case bigFloatType:
+			v, err := c.caster.ToBigFloatE(input)
+			c.setBig(output, v, err, path)
+
+			return
+
+		case bigRatType:
+			v, err := c.caster.ToBigRatE(input)
+			c.setBig(output, v, err, path)
+
+			return
+		}
+	}
+
+	if c.fillUnmarshaler(output, input, path) {
+		return
+	}
+
+	if isNumberKind(output.Kind()) {
+		var err error
+
+		switch {
+		case options.Has(ByteSizeOption):
+			input, err = c.caster.ToByteSizeE(input)
+
+		case options.Has(QuantityOption):
+			if output.Kind() == reflect.Float32 || output.Kind() == reflect.Float64 {
+				input, err = c.caster.ToQuantityFloat64E(input)
+			} else {
+				input, err = c.caster.ToQuantityE(input)
+			}
+
+		}
+
+		c.setFieldError(path, err)
+	}
+
 	switch output.Kind() {
 
 	case reflect.Ptr:
@@ -116,11 +314,11 @@ func (c *Converter) fillOutput(output reflect.Value, input interface{}, path str
 				output.Set(reflect.New(output.Type().Elem()))
 			}
 
-			c.fillOutput(output.Elem(), input, path)
+			c.fillOutput(output.Elem(), input, path, options)
 		}
 
 	case reflect.Interface:
-		c.fillOutput(output.Elem(), input, path)
+		c.fillOutput(output.Elem(), input, path, options)
 
 	case reflect.Map:
 		inputValue := reflect.ValueOf(input)
@@ -152,12 +350,12 @@ func (c *Converter) fillOutput(output reflect.Value, input interface{}, path str
 			output.Set(reflect.MakeMap(output.Type()))
 			for i := range values {
 				key := reflect.New(keyType).Elem()
-				c.fillOutput(key, i, path)
+				c.fillOutput(key, i, path, nil)
 
 				if valueType.Kind() != reflect.Interface {
 					value = reflect.New(valueType).Elem()
 					childPath := c.getPath(path, fmt.Sprintf("{%q}", i))
-					c.fillOutput(value, values[i], childPath)
+					c.fillOutput(value, values[i], childPath, options)
 				} else {
 					value = reflect.ValueOf(values[i])
 				}
@@ -173,19 +371,11 @@ func (c *Converter) fillOutput(output reflect.Value, input interface{}, path str
 			output.Set(reflect.MakeSlice(output.Type(), inputValue.Len(), inputValue.Cap()))
 
 			for i := 0; i < output.Len(); i++ {
-				c.fillOutput(output.Index(i), inputValue.Index(i).Interface(), c.getPath(path, fmt.Sprintf("[%d]", i)))
+				c.fillOutput(output.Index(i), inputValue.Index(i).Interface(), c.getPath(path, fmt.Sprintf("[%d]", i)), options)
 			}
 		}
 
 	case reflect.Struct:
-		// Custom types
-		if output.Type() == timeType {
-			c.setValueFields[path] = true
-			output.Set(reflect.ValueOf(ToTime(input)))
-
-			break
-		}
-
 		values := map[string]interface{}{}
 
 		if inputCast, ok := input.(map[string]interface{}); ok {
@@ -217,68 +407,294 @@ func (c *Converter) fillOutput(output reflect.Value, input interface{}, path str
 				childPath := c.getPath(path, name)
 
 				if value, ok := values[name]; ok {
-					c.fillOutput(output.Field(i), value, childPath)
+					c.fillOutput(output.Field(i), value, childPath, c.getOptions(output.Type().Field(i)))
 				}
 			}
 		}
 
 	case reflect.Bool:
-		c.setValueFields[path] = true
-		output.SetBool(ToBool(input))
+		v, err := c.caster.ToBoolE(input)
+		c.setBool(output, v, err, path)
 
 	case reflect.String:
-		c.setValueFields[path] = true
-		output.SetString(ToString(input))
+		v, err := c.caster.ToStringE(input)
+		c.setString(output, v, err, path)
 
 	case reflect.Uint:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToUint(input)))
+		v, err := c.caster.ToUintE(input)
+		c.setUint(output, uint64(v), err, path)
 
 	case reflect.Uint8:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToUint8(input)))
+		v, err := c.caster.ToUint8E(input)
+		c.setUint(output, uint64(v), err, path)
 
 	case reflect.Uint16:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToUint16(input)))
+		v, err := c.caster.ToUint16E(input)
+		c.setUint(output, uint64(v), err, path)
 
 	case reflect.Uint32:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToUint32(input)))
+		v, err := c.caster.ToUint32E(input)
+		c.setUint(output, uint64(v), err, path)
 
 	case reflect.Uint64:
-		c.setValueFields[path] = true
-		output.SetUint(ToUint64(input))
+		v, err := c.caster.ToUint64E(input)
+		c.setUint(output, v, err, path)
 
 	case reflect.Int:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToInt(input)))
+		v, err := c.caster.ToIntE(input)
+		c.setInt(output, int64(v), err, path)
 
 	case reflect.Int8:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToInt8(input)))
+		v, err := c.caster.ToInt8E(input)
+		c.setInt(output, int64(v), err, path)
 
 	case reflect.Int16:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToInt16(input)))
+		v, err := c.caster.ToInt16E(input)
+		c.setInt(output, int64(v), err, path)
 
 	case reflect.Int32:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToInt32(input)))
+		v, err := c.caster.ToInt32E(input)
+		c.setInt(output, int64(v), err, path)
 
 	case reflect.Int64:
-		c.setValueFields[path] = true
-		output.SetInt(ToInt64(input))
+		v, err := c.caster.ToInt64E(input)
+		c.setInt(output, v, err, path)
 
 	case reflect.Float32:
-		c.setValueFields[path] = true
-		output.Set(reflect.ValueOf(ToFloat32(input)))
+		v, err := c.caster.ToFloat32E(input)
+		c.setFloat(output, float64(v), err, path)
 
 	case reflect.Float64:
+		v, err := c.caster.ToFloat64E(input)
+		c.setFloat(output, v, err, path)
+
+	case reflect.Complex64:
+		v, err := c.caster.ToComplex64E(input)
+		c.setComplex(output, complex128(v), err, path)
+
+	case reflect.Complex128:
+		v, err := c.caster.ToComplex128E(input)
+		c.setComplex(output, v, err, path)
+
+	}
+}
+
+// fillRegistered fills output with a conversion from the registry and
+// reports whether there was one for the input and the output types
+func (c *Converter) fillRegistered(output reflect.Value, input interface{}, path string) bool {
+	if !output.IsValid() {
+		return false
+	}
+
+	value, ok, err := c.caster.Registry.Cast(input, output.Type())
+	if !ok {
+		return false
+	}
+
+	c.setValueFields[path] = true
+	c.wholeValueFields[path] = true
+
+	if err != nil {
+		c.setFieldError(path, err)
+		return true
+	}
+
+	output.Set(reflect.ValueOf(value))
+
+	return true
+}
+
+// fillConvertible calls the ConvertFrom hook of Convertible outputs and
+// reports whether output was handled
+func (c *Converter) fillConvertible(output reflect.Value, input interface{}, path string) bool {
+	if !output.CanAddr() {
+		return false
+	}
+
+	convertible, ok := output.Addr().Interface().(Convertible)
+	if !ok {
+		return false
+	}
+
+	c.setValueFields[path] = true
+	c.wholeValueFields[path] = true
+
+	if err := convertible.ConvertFrom(input); err != nil {
+		c.setFieldError(path, newCastError(input, output.Type(), err))
+	}
+
+	return true
+}
+
+// fillUnmarshaler fills outputs implementing encoding.TextUnmarshaler
+// from text inputs and outputs implementing json.Unmarshaler from any
+// other input re-encoded as JSON. It reports whether output was handled
+func (c *Converter) fillUnmarshaler(output reflect.Value, input interface{}, path string) bool {
+	if !output.CanAddr() {
+		return false
+	}
+
+	target := output.Addr().Interface()
+	textUnmarshaler, isText := target.(encoding.TextUnmarshaler)
+	jsonUnmarshaler, isJSON := target.(json.Unmarshaler)
+
+	if !isText && !isJSON {
+		return false
+	}
+
+	if value := reflect.ValueOf(input); value.Type() == output.Type() {
+		c.setValueFields[path] = true
+		c.wholeValueFields[path] = true
+		output.Set(value)
+
+		return true
+	}
+
+	var err error
+
+	if text, ok := textInput(input); ok && isText {
+		err = textUnmarshaler.UnmarshalText([]byte(text))
+	} else if isJSON {
+		var b []byte
+		if b, err = json.Marshal(input); err == nil {
+			err = jsonUnmarshaler.UnmarshalJSON(b)
+		}
+	} else if text, err = c.caster.ToStringE(input); err == nil {
+		// numbers and other inputs still pass the validation of the type
+		err = textUnmarshaler.UnmarshalText([]byte(text))
+	}
+
+	c.setValueFields[path] = true
+	c.wholeValueFields[path] = true
+
+	if err != nil {
+		c.setFieldError(path, newCastError(input, output.Type(), err))
+	}
+
+	return true
+}
+
+// fillNull fills the value field of a sql.Null* output and sets Valid if
+// the conversion succeeded. nil and NULL inputs reset the output to NULL
+func (c *Converter) fillNull(output reflect.Value, input interface{}, path string, options tagOptions) {
+	value, _, err := unwrapValuer(input)
+
+	switch {
+	case err != nil && !errors.Is(err, ErrNil):
+		c.setFieldError(path, err)
+
+	case err != nil || isNil(value):
 		c.setValueFields[path] = true
-		output.SetFloat(ToFloat64(input))
+		c.wholeValueFields[path] = true
+		output.Set(reflect.Zero(output.Type()))
+
+	default:
+		c.fillOutput(output.Field(0), value, path, options)
+
+		if _, failed := c.fieldErrors[path]; failed {
+			c.wholeValueFields[path] = true
+			output.Set(reflect.Zero(output.Type()))
+			return
+		}
+
+		output.Field(1).SetBool(true)
+	}
+}
+
+func (c *Converter) setBool(output reflect.Value, v bool, err error, path string) {
+	c.setValueFields[path] = true
+	output.SetBool(v)
+	c.setCastError(path, err)
+}
+
+func (c *Converter) setString(output reflect.Value, v string, err error, path string) {
+	c.setValueFields[path] = true
+	output.SetString(v)
+	c.setCastError(path, err)
+}
+
+func (c *Converter) setInt(output reflect.Value, v int64, err error, path string) {
+	c.setValueFields[path] = true
+	output.SetInt(v)
+	c.setCastError(path, err)
+}
+
+func (c *Converter) setUint(output reflect.Value, v uint64, err error, path string) {
+	c.setValueFields[path] = true
+	output.SetUint(v)
+	c.setCastError(path, err)
+}
+
+func (c *Converter) setFloat(output reflect.Value, v float64, err error, path string) {
+	c.setValueFields[path] = true
+	output.SetFloat(v)
+	c.setCastError(path, err)
+}
+
+func (c *Converter) setComplex(output reflect.Value, v complex128, err error, path string) {
+	c.setValueFields[path] = true
+	output.SetComplex(v)
+	c.setCastError(path, err)
+}
+
+// setBig stores a *big.Int, *big.Float or *big.Rat into a field of the
+// matching struct type. The values are copied with Set, as math/big does
+// not support shallow copies
+func (c *Converter) setBig(output reflect.Value, v interface{}, err error, path string) {
+	c.setValueFields[path] = true
+	c.wholeValueFields[path] = true
+
+	if isNil(v) {
+		v = nil
+	}
+
+	switch value := v.(type) {
+	case *big.Int:
+		output.Addr().Interface().(*big.Int).Set(value)
+
+	case *big.Float:
+		output.Addr().Interface().(*big.Float).Set(value)
+
+	case *big.Rat:
+		output.Addr().Interface().(*big.Rat).Set(value)
+	}
+
+	c.setFieldError(path, err)
+}
+
+func (c *Converter) setFieldError(path string, err error) {
+	if err == nil {
+		return
+	}
+
+	c.fieldErrors[path] = err
+	c.addInvalidField(path)
+}
+
+// setCastError keeps the error of a plain cast. Only errors meaning the
+// value does not fit the field, including fractions rejected by the
+// Rounding, make it invalid. Other failures leave a zero value in place
+// as the Converter always did
+func (c *Converter) setCastError(path string, err error) {
+	if err == nil {
+		return
+	}
+
+	c.fieldErrors[path] = err
+
+	if isRangeError(err) || errors.Is(err, ErrNotInteger) {
+		c.addInvalidField(path)
+	}
+}
 
+func (c *Converter) addInvalidField(path string) {
+	for _, p := range c.invalidFields {
+		if p == path {
+			return
+		}
 	}
+
+	c.invalidFields = append(c.invalidFields, path)
 }
 
 func (c *Converter) setAllowZeroFieldsPath(path string) {
@@ -346,8 +762,22 @@ func (c *Converter) validateExec(output reflect.Value, path string, fieldPath st
 	output = reflect.Indirect(output)
 	valid := true
 
+	// values set as a whole, e.g. unmarshaled ones or NULL, are not
+	// checked element by element
+	if _, ok := c.wholeValueFields[fieldPath]; ok {
+		switch output.Kind() {
+		case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+			return
+		}
+	}
+
 	switch output.Kind() {
 	case reflect.Struct:
+		if isNullType(output.Type()) {
+			valid = output.Field(1).Bool()
+			break
+		}
+
 		for i := 0; i < output.NumField(); i++ {
 			field := output.Type().Field(i)
 			val := output.FieldByName(field.Name)
@@ -405,7 +835,46 @@ func (c *Converter) validateExec(output reflect.Value, path string, fieldPath st
 		}
 
 		if !valid {
-			c.invalidFields = append(c.invalidFields, fieldPath)
+			c.addInvalidField(fieldPath)
 		}
 	}
 }
+
+type tagOptions []string
+
+func (o tagOptions) Has(option string) bool {
+	for _, v := range o {
+		if strings.TrimSpace(v) == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// textInput returns the text of string-like inputs and of inputs which
+// can describe themselves as text
+func textInput(in interface{}) (string, bool) {
+	if s, ok := stringLike(in); ok {
+		return s, true
+	}
+
+	switch in.(type) {
+	case encoding.TextMarshaler, fmt.Stringer:
+		s, err := ToStringE(in)
+		return s, err == nil
+	}
+
+	return "", false
+}
@@ -1,6 +1,13 @@
 package gotypes
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -212,3 +219,915 @@ func Test_StructWithJsonTagToMapStringInterface_ResultIsValid(t *testing.T) {
 	assert.True(t, valid)
 	assert.Equal(t, output["field"], "test")
 }
+
+func Test_MapWithOverflowValueToStruct_ResultIsNotValid(t *testing.T) {
+	output := struct {
+		Small uint8
+		Big   int64
+	}{}
+	input := map[string]interface{}{
+		"Small": 300,
+		"Big":   300,
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.Equal(t, converter.GetInvalidFields(), []string{"Small"})
+	assert.ErrorIs(t, converter.GetFieldErrors()["Small"], ErrOverflow)
+	assert.Exactly(t, output.Small, uint8(255))
+	assert.Exactly(t, output.Big, int64(300))
+}
+
+func Test_MapWithUnparsableValueToStruct_ResultIsValid(t *testing.T) {
+	output := struct {
+		Count  int64
+		Amount float64
+		Flag   bool
+	}{}
+	input := map[string]interface{}{
+		"Count":  "many",
+		"Amount": "",
+		"Flag":   []int{1},
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Empty(t, converter.GetInvalidFields())
+	assert.Error(t, converter.GetFieldErrors()["Count"])
+	assert.Exactly(t, output.Count, int64(0))
+	assert.Exactly(t, output.Amount, float64(0))
+	assert.False(t, output.Flag)
+}
+
+func Test_NilPointerInput_ResultIsNotValid(t *testing.T) {
+	var input *map[string]interface{}
+	output := MapStruct{}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.Equal(t, converter.GetInvalidFields(), []string{"StructField"})
+}
+
+func Test_MapWithNilValuesToStruct_ResultIsValid(t *testing.T) {
+	var nilInt *int
+
+	output := struct {
+		Optional *int
+		Skipped  int `json:",omitempty"`
+	}{}
+	input := map[string]interface{}{
+		"Optional": nilInt,
+		"Skipped":  nil,
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Nil(t, output.Optional)
+}
+
+func Test_StringToTimeWithCustomLayouts_ResultIsValid(t *testing.T) {
+	outTime := time.Date(2016, time.August, 19, 0, 0, 0, 0, time.UTC)
+
+	output := TimeStruct{}
+	input := map[string]interface{}{
+		"Time": "19/08/2016",
+	}
+
+	converter := NewConverter(input, &output).SetTimeLayouts(NewTimeLayouts("02/01/2006"))
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.Time, outTime)
+}
+
+func Test_StringToTimeWithUnknownLayout_ResultIsValid(t *testing.T) {
+	output := TimeStruct{}
+	input := map[string]interface{}{
+		"Time": "19/08/2016",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.True(t, output.Time.IsZero())
+	assert.ErrorIs(t, converter.GetFieldErrors()["Time"], ErrUnknownTimeLayout)
+}
+
+func Test_EmptyStringToTime_ResultIsValid(t *testing.T) {
+	output := TimeStruct{}
+	input := map[string]interface{}{
+		"Time": "",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.True(t, output.Time.IsZero())
+}
+
+func Test_StringToTimeWithLocation_ResultIsValid(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	outTime := time.Date(2016, time.August, 19, 18, 55, 0, 0, loc)
+
+	output := TimeStruct{}
+	input := map[string]interface{}{
+		"Time": "19.08.2016 18:55:00",
+	}
+
+	converter := NewConverter(input, &output).SetLocation(loc)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.Time, outTime)
+}
+
+func Test_NumberToDurationWithUnit_ResultIsValid(t *testing.T) {
+	output := struct {
+		Timeout time.Duration
+	}{}
+	input := map[string]interface{}{
+		"Timeout": 1500,
+	}
+
+	converter := NewConverter(input, &output).SetDurationUnit(time.Millisecond)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Timeout, 1500*time.Millisecond)
+}
+
+type DurationStruct struct {
+	Duration time.Duration
+}
+
+type DurationPointerStruct struct {
+	Duration *time.Duration
+}
+
+type DurationSliceStruct struct {
+	Durations []time.Duration
+}
+
+func Test_StringToDurationField_ResultIsValid(t *testing.T) {
+	output := DurationStruct{}
+	input := map[string]interface{}{
+		"Duration": "1h30m",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Duration, 90*time.Minute)
+}
+
+func Test_StringToDurationByPointer_ResultIsValid(t *testing.T) {
+	output := DurationPointerStruct{}
+	input := map[string]interface{}{
+		"Duration": "PT2M",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, *output.Duration, 2*time.Minute)
+}
+
+func Test_SliceToDurationSlice_ResultIsValid(t *testing.T) {
+	output := DurationSliceStruct{}
+	input := map[string]interface{}{
+		"Durations": []interface{}{"1s", 2, "3d", 4 * time.Millisecond},
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.Durations, []time.Duration{time.Second, 2 * time.Second, 3 * Day, 4 * time.Millisecond})
+}
+
+func Test_MapToDurationMap_ResultIsValid(t *testing.T) {
+	output := map[string]time.Duration{}
+	input := map[string]interface{}{
+		"read":  "5s",
+		"write": "1m",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output, map[string]time.Duration{"read": 5 * time.Second, "write": time.Minute})
+}
+
+func Test_InvalidStringToDuration_ResultIsValid(t *testing.T) {
+	output := DurationStruct{}
+	input := map[string]interface{}{
+		"Duration": "soon",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.Duration, time.Duration(0))
+	assert.ErrorIs(t, converter.GetFieldErrors()["Duration"], ErrInvalidDuration)
+}
+
+func Test_FloatToStringWithFormat_ResultIsValid(t *testing.T) {
+	output := MapStruct{}
+	input := map[string]interface{}{
+		"StructField": 3.14159,
+	}
+
+	converter := NewConverter(input, &output).SetFloatFormat(FloatFormat{Verb: 'f', Precision: 2})
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.StructField, "3.14")
+}
+
+func Test_LocalizedStringsToNumbers_ResultIsValid(t *testing.T) {
+	output := struct {
+		Price    float64
+		Quantity int
+	}{}
+	input := map[string]interface{}{
+		"Price":    "1.234,56",
+		"Quantity": "1.000",
+	}
+
+	converter := NewConverter(input, &output).SetLocale(LocaleDE)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Price, 1234.56)
+	assert.Exactly(t, output.Quantity, 1000)
+}
+
+func Test_LocalizedStringsWithCaster_ResultIsValid(t *testing.T) {
+	caster := NewCaster()
+	caster.Locale = LocaleDE
+
+	output := struct {
+		Price float64
+	}{}
+	input := map[string]interface{}{
+		"Price": "1.234,56",
+	}
+
+	converter := NewConverter(input, &output).SetCaster(caster)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Price, 1234.56)
+}
+
+func Test_LocalizedPercentToFloat_ResultIsValid(t *testing.T) {
+	output := struct {
+		Rate float64
+	}{}
+	input := map[string]interface{}{
+		"Rate": "12,5 %",
+	}
+
+	converter := NewConverter(input, &output).SetLocale(LocaleDE)
+
+	assert.True(t, converter.Valid())
+	assert.Empty(t, converter.GetFieldErrors())
+	assert.Exactly(t, output.Rate, 12.5)
+}
+
+type ResourcesStruct struct {
+	Memory  uint64    `json:"memory" gotypes:"bytesize"`
+	Disks   []int64   `json:"disks" gotypes:"bytesize"`
+	CPU     float64   `json:"cpu" gotypes:"quantity"`
+	Storage *int64    `json:"storage" gotypes:"quantity"`
+	Ratios  []float32 `json:"ratios"`
+}
+
+func Test_SuffixedStringsToNumbers_ResultIsValid(t *testing.T) {
+	output := ResourcesStruct{}
+	input := map[string]interface{}{
+		"memory":  "512MiB",
+		"disks":   []interface{}{"1GB", "2Gi"},
+		"cpu":     "500m",
+		"storage": "10Gi",
+		"ratios":  []interface{}{"0.5"},
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Memory, uint64(512*1024*1024))
+	assert.Equal(t, output.Disks, []int64{1000000000, 2 * 1024 * 1024 * 1024})
+	assert.Exactly(t, output.CPU, 0.5)
+	assert.Exactly(t, *output.Storage, int64(10*1024*1024*1024))
+}
+
+func Test_SuffixedStringsWithLocale_ResultIsValid(t *testing.T) {
+	output := ResourcesStruct{}
+	input := map[string]interface{}{
+		"memory":  "1.5GB",
+		"disks":   []interface{}{"1GB"},
+		"cpu":     "500m",
+		"storage": "10Gi",
+		"ratios":  []interface{}{"0,5"},
+	}
+
+	converter := NewConverter(input, &output).SetLocale(LocaleDE)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Memory, uint64(1500000000))
+	assert.Equal(t, output.Ratios, []float32{0.5})
+}
+
+func Test_SuffixedStringsWithCaster_ResultIsValid(t *testing.T) {
+	caster := NewCaster()
+	caster.Registry = NewRegistry()
+	RegisterCast(caster.Registry, func(m Money) (uint64, error) {
+		return uint64(m) * 1024, nil
+	})
+
+	output := struct {
+		Memory uint64 `gotypes:"bytesize"`
+	}{}
+	input := map[string]interface{}{
+		"Memory": Money(2),
+	}
+
+	converter := NewConverter(input, &output).SetCaster(caster)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Memory, uint64(2048))
+}
+
+func Test_SuffixedStringWithoutOption_ResultIsValid(t *testing.T) {
+	output := struct {
+		Memory uint64
+	}{}
+	input := map[string]interface{}{
+		"Memory": "512MiB",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.Memory, uint64(0))
+	assert.Error(t, converter.GetFieldErrors()["Memory"])
+}
+
+func Test_FractionalToIntegerWithRounding_ResultIsValid(t *testing.T) {
+	output := struct {
+		Amount int64
+		Count  uint
+		Ratio  float64
+	}{}
+	input := map[string]interface{}{
+		"Amount": 10.5,
+		"Count":  "2.5",
+		"Ratio":  "0.25",
+	}
+
+	converter := NewConverter(input, &output).SetRounding(RoundHalfUp)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Amount, int64(11))
+	assert.Exactly(t, output.Count, uint(3))
+	assert.Exactly(t, output.Ratio, 0.25)
+}
+
+func Test_LargeJSONNumberToIntegerWithRounding_ResultIsValid(t *testing.T) {
+	output := struct {
+		ID int64
+	}{}
+	input := map[string]interface{}{
+		"ID": json.Number("9007199254740993.4"),
+	}
+
+	converter := NewConverter(input, &output).SetRounding(RoundHalfUp)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.ID, int64(9007199254740993))
+}
+
+func Test_FractionalToIntegerWithRejectRounding_ResultIsNotValid(t *testing.T) {
+	output := struct {
+		Amount int64
+	}{}
+	input := map[string]interface{}{
+		"Amount": "10.01",
+	}
+
+	converter := NewConverter(input, &output).SetRounding(RoundReject)
+
+	assert.False(t, converter.Valid())
+	assert.ErrorIs(t, converter.GetFieldErrors()["Amount"], ErrNotInteger)
+}
+
+func Test_FractionalStringToIntegerWithDefaultRounding_ResultIsNotValid(t *testing.T) {
+	output := struct {
+		Amount int64
+	}{}
+	input := map[string]interface{}{
+		"Amount": "1.5",
+	}
+
+	converter := NewConverter(input, &output)
+
+	var castErr *CastError
+
+	assert.False(t, converter.Valid())
+	assert.ErrorIs(t, converter.GetFieldErrors()["Amount"], ErrNotInteger)
+	assert.True(t, errors.As(converter.GetFieldErrors()["Amount"], &castErr))
+	assert.Exactly(t, output.Amount, int64(1))
+}
+
+type BigStruct struct {
+	Int   big.Int
+	Float *big.Float
+	Rat   big.Rat
+}
+
+func Test_StringsToBigFields_ResultIsValid(t *testing.T) {
+	output := BigStruct{}
+	input := map[string]interface{}{
+		"Int":   "123456789012345678901234567890",
+		"Float": 1.5,
+		"Rat":   "1/3",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Int.String(), "123456789012345678901234567890")
+	assert.Exactly(t, output.Float.Text('g', -1), "1.5")
+	assert.Exactly(t, output.Rat.String(), "1/3")
+}
+
+func Test_InvalidStringToBigField_ResultIsNotValid(t *testing.T) {
+	output := BigStruct{}
+	input := map[string]interface{}{
+		"Int": "1.5",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.ErrorIs(t, converter.GetFieldErrors()["Int"], ErrNotInteger)
+}
+
+func Test_ZeroStringsToBigFields_ResultIsValid(t *testing.T) {
+	output := struct {
+		I big.Int
+		F big.Float
+		R big.Rat
+	}{}
+	input := map[string]interface{}{
+		"I": "0",
+		"F": "0",
+		"R": "0",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Empty(t, converter.GetInvalidFields())
+	assert.Exactly(t, output.I.Sign(), 0)
+	assert.Exactly(t, output.F.Sign(), 0)
+	assert.Exactly(t, output.R.Sign(), 0)
+}
+
+func Test_BigPointersToBigFields_AreCopied(t *testing.T) {
+	in := big.NewInt(42)
+	output := BigStruct{}
+	input := map[string]interface{}{
+		"Int": in,
+	}
+
+	converter := NewConverter(input, &output)
+	converter.Valid()
+	in.SetInt64(7)
+
+	assert.Exactly(t, output.Int.String(), "42")
+}
+
+func Test_StringsToComplexFields_ResultIsValid(t *testing.T) {
+	output := struct {
+		Small complex64
+		Large complex128
+		Real  *complex128
+	}{}
+	input := map[string]interface{}{
+		"Small": "1+2i",
+		"Large": complex(3, -4),
+		"Real":  5,
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Small, complex64(complex(1, 2)))
+	assert.Exactly(t, output.Large, complex(3, -4))
+	assert.Exactly(t, *output.Real, complex(5, 0))
+}
+
+func Test_InvalidStringToComplexField_ResultIsValid(t *testing.T) {
+	output := struct {
+		Value complex128
+	}{}
+	input := map[string]interface{}{
+		"Value": "one plus two i",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.Value, complex128(0))
+	assert.Error(t, converter.GetFieldErrors()["Value"])
+}
+
+func Test_JSONNumbersToFields_ResultIsValid(t *testing.T) {
+	output := struct {
+		ID    int64
+		Count uint32
+		Ratio float64
+		Label string
+	}{}
+	input := map[string]interface{}{
+		"ID":    json.Number("9007199254740993"),
+		"Count": json.Number("2.5"),
+		"Ratio": json.Number("0.25"),
+		"Label": json.Number("1e3"),
+	}
+
+	converter := NewConverter(input, &output).SetRounding(RoundHalfUp)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.ID, int64(9007199254740993))
+	assert.Exactly(t, output.Count, uint32(3))
+	assert.Exactly(t, output.Ratio, 0.25)
+	assert.Exactly(t, output.Label, "1e3")
+}
+
+func Test_InvalidJSONNumberToField_ResultIsValid(t *testing.T) {
+	output := struct {
+		ID int64
+	}{}
+	input := map[string]interface{}{
+		"ID": json.Number("0x10"),
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Equal(t, output.ID, int64(0))
+	assert.ErrorIs(t, converter.GetFieldErrors()["ID"], ErrInvalidNumber)
+}
+
+type NullStruct struct {
+	Name    sql.NullString
+	Age     sql.NullInt64
+	Updated sql.NullTime
+	Score   *sql.NullFloat64
+}
+
+func Test_PlainInputsToSQLNullFields_ResultIsValid(t *testing.T) {
+	output := NullStruct{}
+	input := map[string]interface{}{
+		"Name":    "foo",
+		"Age":     "42",
+		"Updated": "2020-01-02",
+		"Score":   1.5,
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Name, sql.NullString{String: "foo", Valid: true})
+	assert.Exactly(t, output.Age, sql.NullInt64{Int64: 42, Valid: true})
+	assert.Exactly(t, output.Updated, sql.NullTime{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true})
+	assert.Exactly(t, *output.Score, sql.NullFloat64{Float64: 1.5, Valid: true})
+}
+
+func Test_NullInputsToSQLNullFields_ResultIsValid(t *testing.T) {
+	output := NullStruct{
+		Name: sql.NullString{String: "bar", Valid: true},
+	}
+	input := map[string]interface{}{
+		"Name":    nil,
+		"Age":     sql.NullString{},
+		"Updated": sql.NullTime{},
+		"Score":   nil,
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Name, sql.NullString{})
+	assert.Exactly(t, output.Age, sql.NullInt64{})
+	assert.Nil(t, output.Score)
+}
+
+func Test_MissingInputsToSQLNullFields_ResultIsNotValid(t *testing.T) {
+	output := struct {
+		Name sql.NullString
+		Age  sql.NullInt64 `json:",omitempty"`
+	}{}
+	input := map[string]interface{}{}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.Equal(t, converter.GetInvalidFields(), []string{"Name"})
+}
+
+func Test_InvalidInputToSQLNullField_ResultIsNull(t *testing.T) {
+	output := struct {
+		Age sql.NullInt64
+	}{}
+	input := map[string]interface{}{
+		"Age": "forty two",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.False(t, output.Age.Valid)
+	assert.Error(t, converter.GetFieldErrors()["Age"])
+}
+
+func Test_SQLNullInputsToPlainFields_ResultIsValid(t *testing.T) {
+	output := struct {
+		Name string
+		Age  int
+	}{
+		Name: "bar",
+	}
+	input := map[string]interface{}{
+		"Name": sql.NullString{},
+		"Age":  sql.NullInt64{Int64: 42, Valid: true},
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Name, "bar")
+	assert.Exactly(t, output.Age, 42)
+}
+
+type Level int
+
+func (l Level) MarshalText() ([]byte, error) {
+	switch l {
+	case 1:
+		return []byte("debug"), nil
+
+	case 2:
+		return []byte("info"), nil
+	}
+
+	return nil, errors.New("unknown level")
+}
+
+func (l *Level) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "debug":
+		*l = 1
+
+	case "info":
+		*l = 2
+
+	default:
+		return errors.New("unknown level " + string(text))
+	}
+
+	return nil
+}
+
+type Point struct {
+	X, Y int
+}
+
+func (p *Point) UnmarshalJSON(b []byte) error {
+	var xy []int
+	if err := json.Unmarshal(b, &xy); err != nil {
+		return err
+	}
+
+	if len(xy) != 2 {
+		return errors.New("point needs two coordinates")
+	}
+
+	p.X, p.Y = xy[0], xy[1]
+
+	return nil
+}
+
+type UnmarshalerStruct struct {
+	Level   Level
+	Levels  []Level
+	IP      net.IP
+	Address *net.IP
+	Point   Point
+}
+
+func Test_InputsToUnmarshalerFields_ResultIsValid(t *testing.T) {
+	output := UnmarshalerStruct{}
+	input := map[string]interface{}{
+		"Level":   "info",
+		"Levels":  []interface{}{"debug", []byte("info")},
+		"IP":      "192.168.1.1",
+		"Address": net.ParseIP("::1"),
+		"Point":   []interface{}{1, 2},
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Level, Level(2))
+	assert.Exactly(t, output.Levels, []Level{1, 2})
+	assert.Exactly(t, output.IP.String(), "192.168.1.1")
+	assert.Exactly(t, output.Address.String(), "::1")
+	assert.Exactly(t, output.Point, Point{X: 1, Y: 2})
+}
+
+func Test_NumberToTextUnmarshalerField_ResultIsNotValid(t *testing.T) {
+	output := struct {
+		Level Level
+	}{}
+	input := map[string]interface{}{
+		"Level": 7,
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.Contains(t, converter.GetInvalidFields(), "Level")
+	assert.Exactly(t, output.Level, Level(0))
+}
+
+func Test_InvalidInputsToUnmarshalerFields_ResultIsNotValid(t *testing.T) {
+	output := UnmarshalerStruct{}
+	input := map[string]interface{}{
+		"Level": "trace",
+		"IP":    "not an ip",
+		"Point": []interface{}{1},
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.EqualError(t, converter.GetFieldErrors()["Level"], `gotypes: unable to cast "trace" of type string to gotypes.Level: unknown level trace`)
+	assert.Error(t, converter.GetFieldErrors()["IP"])
+	assert.EqualError(t, errors.Unwrap(converter.GetFieldErrors()["Point"]), "point needs two coordinates")
+}
+
+type Celsius float64
+
+func (c *Celsius) ConvertFrom(input interface{}) error {
+	s := strings.TrimSpace(ToString(input))
+
+	if strings.HasSuffix(s, "F") {
+		f, err := ToFloat64E(strings.TrimSuffix(s, "F"))
+		if err != nil {
+			return errors.Unwrap(err)
+		}
+
+		*c = Celsius((f - 32) * 5 / 9)
+
+		return nil
+	}
+
+	v, err := ToFloat64E(strings.TrimSuffix(s, "C"))
+	if err != nil {
+		return errors.Unwrap(err)
+	}
+
+	*c = Celsius(v)
+
+	return nil
+}
+
+type Tags []string
+
+func (t *Tags) ConvertFrom(input interface{}) error {
+	*t = strings.Split(ToString(input), " ")
+	return nil
+}
+
+type ConvertibleStruct struct {
+	Inside  Celsius
+	Outside *Celsius
+	Tags    Tags
+}
+
+func Test_InputsToConvertibleFields_ResultIsValid(t *testing.T) {
+	output := ConvertibleStruct{}
+	input := map[string]interface{}{
+		"Inside":  "21.5C",
+		"Outside": "212F",
+		"Tags":    "a b",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Inside, Celsius(21.5))
+	assert.Exactly(t, *output.Outside, Celsius(100))
+	assert.Exactly(t, output.Tags, Tags{"a", "b"})
+}
+
+func Test_ConvertibleFieldError_ResultIsNotValid(t *testing.T) {
+	output := ConvertibleStruct{}
+	input := map[string]interface{}{
+		"Inside":  "warm",
+		"Outside": "-5C",
+		"Tags":    "a",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.False(t, converter.Valid())
+	assert.Exactly(t, converter.GetInvalidFields(), []string{"Inside"})
+	assert.ErrorIs(t, converter.GetFieldErrors()["Inside"], strconv.ErrSyntax)
+	assert.Exactly(t, *output.Outside, Celsius(-5))
+}
+
+type MoneyStruct struct {
+	Price    Money
+	Discount *Money
+}
+
+func Test_StringsToRegisteredFields_ResultIsValid(t *testing.T) {
+	registry := NewRegistry()
+	RegisterCast(registry, parseMoney)
+
+	output := MoneyStruct{}
+	input := map[string]interface{}{
+		"Price":    "$12.34",
+		"Discount": "$0.99",
+	}
+
+	converter := NewConverter(input, &output).SetRegistry(registry)
+
+	assert.True(t, converter.Valid())
+	assert.Exactly(t, output.Price, Money(1234))
+	assert.Exactly(t, *output.Discount, Money(99))
+}
+
+func Test_StringToRegisteredField_ResultIsNotValid(t *testing.T) {
+	registry := NewRegistry()
+	RegisterCast(registry, parseMoney)
+
+	output := MoneyStruct{}
+	input := map[string]interface{}{
+		"Price":    "twelve",
+		"Discount": 5,
+	}
+
+	converter := NewConverter(input, &output).SetRegistry(registry)
+
+	assert.False(t, converter.Valid())
+	assert.Exactly(t, converter.GetInvalidFields(), []string{"Price"})
+	assert.ErrorIs(t, converter.GetFieldErrors()["Price"], ErrInvalidNumber)
+	assert.Exactly(t, *output.Discount, Money(5))
+}
+
+func Test_ScopedRegistry_IsIsolated(t *testing.T) {
+	RegisterCast(NewRegistry(), parseMoney)
+
+	output := MoneyStruct{}
+	input := map[string]interface{}{
+		"Price": "$12.34",
+	}
+
+	converter := NewConverter(input, &output)
+
+	assert.Equal(t, output.Price, Money(0))
+	assert.Error(t, converter.GetFieldErrors()["Price"])
+}
+
+func Test_ConverterWithCaster_ResultIsValid(t *testing.T) {
+	caster := NewCaster()
+	caster.BoolVocabulary = NewBoolVocabulary([]string{"ja"}, []string{"nein"})
+	caster.Formatter.Float = FloatFormat{Verb: 'f', Precision: 1}
+	caster.Rounding = RoundCeil
+	caster.DurationUnit = time.Minute
+
+	output := struct {
+		Enabled bool
+		Ratio   string
+		Count   int
+		Timeout time.Duration
+	}{}
+	input := map[string]interface{}{
+		"Enabled": "ja",
+		"Ratio":   0.25,
+		"Count":   "1.2",
+		"Timeout": 2,
+	}
+
+	converter := NewConverter(input, &output).SetCaster(caster).SetDurationUnit(time.Second)
+
+	assert.True(t, converter.Valid())
+	assert.True(t, output.Enabled)
+	assert.Exactly(t, output.Ratio, "0.2")
+	assert.Exactly(t, output.Count, 2)
+	assert.Exactly(t, output.Timeout, 2*time.Second)
+	assert.Exactly(t, caster.DurationUnit, time.Minute)
+}
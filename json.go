@@ -0,0 +1,77 @@
+package gotypes
+
+import (
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// jsonNumberRegexp is the number grammar of RFC 8259
+var jsonNumberRegexp = regexp.MustCompile(`^-?(?:0|[1-9]\d*)(?:\.\d+)?(?:[eE][-+]?\d+)?$`)
+
+// jsonNumberToRat parses n exactly, rejecting anything that is not a
+// valid JSON number, such as base prefixes or surrounding spaces
+func jsonNumberToRat(n json.Number) (*big.Rat, error) {
+	if !jsonNumberRegexp.MatchString(string(n)) {
+		return nil, ErrInvalidNumber
+	}
+
+	value, ok := new(big.Rat).SetString(string(n))
+	if !ok {
+		return nil, ErrInvalidNumber
+	}
+
+	return value, nil
+}
+
+// jsonNumberToInt64 parses plain integers directly and everything else,
+// such as "1e3" or "12.5", through an exact rational, so large ids never
+// lose precision on a float64 round trip
+func (c *Caster) jsonNumberToInt64(n json.Number) (int64, error) {
+	if !jsonNumberRegexp.MatchString(string(n)) {
+		return 0, ErrInvalidNumber
+	}
+
+	if castIn, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return castIn, nil
+	}
+
+	value, err := jsonNumberToRat(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.bigToInt64(value)
+}
+
+// jsonNumberToUint64 is the unsigned counterpart of jsonNumberToInt64
+func (c *Caster) jsonNumberToUint64(n json.Number) (uint64, error) {
+	if !jsonNumberRegexp.MatchString(string(n)) {
+		return 0, ErrInvalidNumber
+	}
+
+	if castIn, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		return castIn, nil
+	}
+
+	value, err := jsonNumberToRat(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.bigToUint64(value)
+}
+
+func jsonNumberToFloat64(n json.Number) (float64, error) {
+	if !jsonNumberRegexp.MatchString(string(n)) {
+		return 0, ErrInvalidNumber
+	}
+
+	castIn, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return castIn, ErrOverflow
+	}
+
+	return castIn, nil
+}
@@ -0,0 +1,110 @@
+package gotypes
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_JSONNumber_ToInt64(t *testing.T) {
+	cases := map[json.Number]int64{
+		"9007199254740993":     9007199254740993,
+		"-9223372036854775808": math.MinInt64,
+		"1e3":                  1000,
+		"12.9":                 12,
+		"-0":                   0,
+	}
+
+	for in, out := range cases {
+		result, err := ToInt64E(in)
+
+		assert.NoError(t, err)
+		assert.Exactly(t, result, out)
+	}
+}
+
+func Test_JSONNumberOverflow_ToInt64Error(t *testing.T) {
+	result, err := ToInt64E(json.Number("1e19"))
+
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Exactly(t, result, int64(math.MaxInt64))
+}
+
+func Test_JSONNumberInvalid_ToInt64Error(t *testing.T) {
+	for _, in := range []json.Number{"0x10", "010", " 1", "1/2", ""} {
+		_, err := ToInt64E(in)
+
+		assert.ErrorIs(t, err, ErrInvalidNumber)
+	}
+}
+
+func Test_JSONNumber_ToUint64(t *testing.T) {
+	assert.Exactly(t, ToUint64(json.Number("18446744073709551615")), uint64(math.MaxUint64))
+	assert.Exactly(t, ToUint64(json.Number("2.5e1")), uint64(25))
+}
+
+func Test_JSONNumberNegative_ToUint64Error(t *testing.T) {
+	_, err := ToUint64E(json.Number("-1"))
+
+	assert.ErrorIs(t, err, ErrNegative)
+}
+
+func Test_JSONNumber_ToFloat64(t *testing.T) {
+	assert.Exactly(t, ToFloat64(json.Number("1.25e-2")), 0.0125)
+}
+
+func Test_JSONNumberOverflow_ToFloat64Error(t *testing.T) {
+	_, err := ToFloat64E(json.Number("1e400"))
+
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_JSONNumber_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(json.Number("12345678901234567890.50")), "12345678901234567890.50")
+}
+
+func Test_JSONNumber_ToBigInt(t *testing.T) {
+	assert.Exactly(t, ToBigInt(json.Number("123456789012345678901234567890")).String(), "123456789012345678901234567890")
+}
+
+func Test_JSONNumberLargeDecimal_RoundingToInt64(t *testing.T) {
+	result, err := RoundHalfUp.ToInt64E(json.Number("9007199254740993.4"))
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(9007199254740993))
+
+	resultUint, err := RoundCeil.ToUint64E(json.Number("9007199254740993.4"))
+
+	assert.NoError(t, err)
+	assert.Exactly(t, resultUint, uint64(9007199254740994))
+}
+
+func Test_JSONNumber_ToTime(t *testing.T) {
+	result, err := ToTimeE(json.Number("1700000000"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC))
+
+	result, err = ToTimeE(json.Number("1700000000.5"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 500000000, time.UTC))
+
+	_, err = ToTimeE(json.Number("2023-11-14"))
+
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+}
+
+func Test_DecodedJSON_ToInt64(t *testing.T) {
+	var out map[string]interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(`{"id": 9007199254740993}`))
+	decoder.UseNumber()
+
+	assert.NoError(t, decoder.Decode(&out))
+	assert.Exactly(t, ToInt64(out["id"]), int64(9007199254740993))
+}
@@ -0,0 +1,245 @@
+package gotypes
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// currencySymbols are stripped from numbers by the predefined locales
+var currencySymbols = []string{"€", "$", "£", "¥", "₽", "₴", "zł", "CHF", "kr"}
+
+// percentSymbols are stripped from the end of numbers by every locale
+var percentSymbols = []string{"%", "‰"}
+
+var (
+	LocaleEN = NewLocale(".", []string{","}, currencySymbols)
+	LocaleDE = NewLocale(",", []string{".", " ", "\u00a0"}, currencySymbols)
+	LocaleFR = NewLocale(",", []string{" ", "\u00a0", "\u202f"}, currencySymbols)
+	LocaleCH = NewLocale(".", []string{"'", "’"}, currencySymbols)
+)
+
+// Locale describes how numbers are written: the decimal separator, the
+// digit grouping separators (the first one is used for formatting) and
+// currency symbols which are stripped from the start or the end of
+// a number when parsing. A trailing percent or per-mille sign is dropped
+// as well without scaling the number, so "15 %" is 15, not 0.15.
+// A Locale is not changed after NewLocale
+type Locale struct {
+	decimal  string
+	grouping []string
+	symbols  []string
+	caster   *Caster
+}
+
+func NewLocale(decimal string, grouping []string, symbols []string) *Locale {
+	return &Locale{
+		decimal:  decimal,
+		grouping: append([]string(nil), grouping...),
+		symbols:  append([]string(nil), symbols...),
+	}
+}
+
+// WithCaster returns a copy of the locale which casts normalized numbers
+// with c instead of a Caster with the package defaults
+func (l *Locale) WithCaster(c *Caster) *Locale {
+	locale := *l
+	locale.caster = c
+
+	return &locale
+}
+
+// getCaster returns a copy of the Caster of the locale, or a new one,
+// using the locale
+func (l *Locale) getCaster() *Caster {
+	c := NewCaster()
+	if l.caster != nil {
+		copied := *l.caster
+		c = &copied
+	}
+
+	c.Locale = l
+
+	return c
+}
+
+// Normalize rewrites a localized number to the form understood by
+// strconv, e.g. "1.234,56 €" becomes "1234.56" for LocaleDE
+func (l *Locale) Normalize(s string) (string, error) {
+	s = l.trimSymbol(strings.TrimSpace(s))
+
+	integer, fraction, hasFraction := s, "", false
+	if l.decimal != "" {
+		parts := strings.Split(s, l.decimal)
+
+		switch len(parts) {
+		case 1:
+
+		case 2:
+			integer, fraction, hasFraction = parts[0], parts[1], true
+
+		default:
+			return s, ErrInvalidNumber
+		}
+	}
+
+	for _, group := range l.grouping {
+		if group == "" {
+			continue
+		}
+
+		if strings.Contains(fraction, group) {
+			return s, ErrInvalidNumber
+		}
+
+		integer = strings.Replace(integer, group, "", -1)
+	}
+
+	integer = strings.TrimSpace(integer)
+
+	if !hasFraction {
+		return integer, nil
+	}
+
+	return integer + "." + fraction, nil
+}
+
+// trimSymbol removes a trailing percent sign and a currency symbol
+// written before the number, also after its sign, or after the number.
+// Symbols made of letters such as "kr" must be separated from the
+// number by a space
+func (l *Locale) trimSymbol(s string) string {
+	for _, symbol := range percentSymbols {
+		if rest := strings.TrimSuffix(s, symbol); len(rest) < len(s) {
+			s = strings.TrimRightFunc(rest, unicode.IsSpace)
+			break
+		}
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign = s[:1]
+	}
+
+	for _, symbol := range l.symbols {
+		if symbol == "" {
+			continue
+		}
+
+		if rest := strings.TrimPrefix(s[len(sign):], symbol); len(rest) < len(s)-len(sign) {
+			if !isWordSymbol(symbol) || strings.TrimLeftFunc(rest, unicode.IsSpace) != rest {
+				return sign + strings.TrimSpace(rest)
+			}
+		}
+
+		if rest := strings.TrimSuffix(s, symbol); len(rest) < len(s) {
+			if !isWordSymbol(symbol) || strings.TrimRightFunc(rest, unicode.IsSpace) != rest {
+				return strings.TrimSpace(rest)
+			}
+		}
+	}
+
+	return s
+}
+
+func isWordSymbol(symbol string) bool {
+	return strings.IndexFunc(symbol, unicode.IsLetter) >= 0
+}
+
+func (l *Locale) ParseFloat(s string) (float64, error) {
+	n, err := l.Normalize(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(n, 64)
+}
+
+func (l *Locale) ParseInt(s string) (int64, error) {
+	n, err := l.Normalize(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return l.getCaster().parseInt(n)
+}
+
+func (l *Locale) ParseUint(s string) (uint64, error) {
+	n, err := l.Normalize(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return l.getCaster().parseUint(n)
+}
+
+func (l *Locale) ToFloat64(in interface{}) float64 {
+	castIn, _ := l.ToFloat64E(in)
+	return castIn
+}
+
+func (l *Locale) ToFloat64E(in interface{}) (float64, error) {
+	return l.getCaster().ToFloat64E(in)
+}
+
+func (l *Locale) ToInt64(in interface{}) int64 {
+	castIn, _ := l.ToInt64E(in)
+	return castIn
+}
+
+func (l *Locale) ToInt64E(in interface{}) (int64, error) {
+	return l.getCaster().ToInt64E(in)
+}
+
+func (l *Locale) ToUint64(in interface{}) uint64 {
+	castIn, _ := l.ToUint64E(in)
+	return castIn
+}
+
+func (l *Locale) ToUint64E(in interface{}) (uint64, error) {
+	return l.getCaster().ToUint64E(in)
+}
+
+// FormatFloat formats v with the format and the separators of the locale
+func (l *Locale) FormatFloat(v float64, format FloatFormat, bitSize int) string {
+	return l.localizeNumber(format.FormatFloat(v, bitSize))
+}
+
+func (l *Locale) FormatInt(v int64) string {
+	return l.localizeNumber(strconv.FormatInt(v, 10))
+}
+
+func (l *Locale) FormatUint(v uint64) string {
+	return l.localizeNumber(strconv.FormatUint(v, 10))
+}
+
+// localizeNumber rewrites a number formatted by strconv using the
+// separators of the locale
+func (l *Locale) localizeNumber(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	integer, rest := s, ""
+	if i := strings.IndexAny(s, ".eEpP"); i >= 0 {
+		integer, rest = s[:i], s[i:]
+	}
+
+	if strings.HasPrefix(rest, ".") {
+		rest = l.decimal + rest[1:]
+	}
+
+	if len(l.grouping) > 0 && l.grouping[0] != "" {
+		var grouped []string
+
+		for len(integer) > 3 {
+			grouped = append([]string{integer[len(integer)-3:]}, grouped...)
+			integer = integer[:len(integer)-3]
+		}
+
+		integer = strings.Join(append([]string{integer}, grouped...), l.grouping[0])
+	}
+
+	return sign + integer + rest
+}
@@ -0,0 +1,114 @@
+package gotypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LocaleDE_Normalize(t *testing.T) {
+	result, err := LocaleDE.Normalize("1.234,56 €")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, "1234.56")
+}
+
+func Test_LocaleInvalidNumber_NormalizeError(t *testing.T) {
+	_, err := LocaleDE.Normalize("1,234,56")
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+
+	_, err = LocaleEN.Normalize("1.23,4")
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+}
+
+func Test_LocaleDE_ToFloat64(t *testing.T) {
+	result, err := LocaleDE.ToFloat64E("1.234,56")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 1234.56)
+}
+
+func Test_LocaleFR_ToInt64(t *testing.T) {
+	assert.Exactly(t, LocaleFR.ToInt64("1 000"), int64(1000))
+	assert.Exactly(t, LocaleFR.ToInt64("-2 500"), int64(-2500))
+}
+
+func Test_LocaleEN_ToUint64(t *testing.T) {
+	assert.Exactly(t, LocaleEN.ToUint64("$1,000,000"), uint64(1000000))
+	assert.Exactly(t, LocaleEN.ToUint64("-$5"), uint64(0))
+}
+
+func Test_LocaleSymbolInTheMiddle_ToInt64Error(t *testing.T) {
+	for _, val := range []string{"kr5", "5kr5", "%15", "1%5"} {
+		_, err := LocaleDE.ToInt64E(val)
+
+		assert.Error(t, err, val)
+	}
+
+	result, err := LocaleDE.ToInt64E("5 kr")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(5))
+}
+
+func Test_LocalePercent_ToFloat64(t *testing.T) {
+	assert.Exactly(t, LocaleEN.ToUint64("15%"), uint64(15))
+	assert.Exactly(t, LocaleDE.ToFloat64("12,5 %"), 12.5)
+	assert.Exactly(t, LocaleFR.ToFloat64("1 000,5 ‰"), 1000.5)
+}
+
+func Test_LocaleSign_Normalize(t *testing.T) {
+	result, err := LocaleEN.Normalize("-$1,234.5")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, "-1234.5")
+}
+
+func Test_NewLocale_CopiesSeparators(t *testing.T) {
+	grouping := []string{"_"}
+	locale := NewLocale(".", grouping, nil)
+	grouping[0] = "."
+
+	assert.Exactly(t, locale.FormatInt(1000), "1_000")
+}
+
+func Test_LocaleNonString_ToFloat64(t *testing.T) {
+	assert.Exactly(t, LocaleDE.ToFloat64(2.5), 2.5)
+}
+
+func Test_LocaleParse(t *testing.T) {
+	f, err := LocaleCH.ParseFloat("1'234.5")
+	assert.NoError(t, err)
+	assert.Exactly(t, f, 1234.5)
+
+	i, err := LocaleDE.ParseInt("12.345")
+	assert.NoError(t, err)
+	assert.Exactly(t, i, int64(12345))
+
+	u, err := LocaleDE.ParseUint("1,5")
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, u, uint64(1))
+}
+
+func Test_LocaleWithCaster_ParseInt(t *testing.T) {
+	c := NewCaster()
+	c.Rounding = RoundHalfUp
+
+	locale := LocaleDE.WithCaster(c)
+
+	i, err := locale.ParseInt("2,5")
+	assert.NoError(t, err)
+	assert.Exactly(t, i, int64(3))
+
+	assert.Exactly(t, locale.ToUint64("1.234,5"), uint64(1235))
+
+	_, err = LocaleDE.ParseInt("2,5")
+	assert.ErrorIs(t, err, ErrNotInteger)
+}
+
+func Test_LocaleFormat(t *testing.T) {
+	assert.Exactly(t, LocaleDE.FormatFloat(1234567.891, FloatFormat{Verb: 'f', Precision: 2}, 64), "1.234.567,89")
+	assert.Exactly(t, LocaleEN.FormatInt(-1234567), "-1,234,567")
+	assert.Exactly(t, LocaleFR.FormatUint(999), "999")
+	assert.Exactly(t, LocaleCH.FormatUint(1000), "1'000")
+}
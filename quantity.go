@@ -0,0 +1,270 @@
+package gotypes
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var (
+	quantityNumberRegexp = regexp.MustCompile(`^[-+]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][-+]?\d+)?`)
+
+	// byteSizeUnits are matched case-insensitively, decimal prefixes are
+	// powers of 1000 and binary (IEC) prefixes are powers of 1024
+	byteSizeUnits = map[string]*big.Rat{
+		"":    ratPow(1000, 0),
+		"b":   ratPow(1000, 0),
+		"k":   ratPow(1000, 1),
+		"kb":  ratPow(1000, 1),
+		"m":   ratPow(1000, 2),
+		"mb":  ratPow(1000, 2),
+		"g":   ratPow(1000, 3),
+		"gb":  ratPow(1000, 3),
+		"t":   ratPow(1000, 4),
+		"tb":  ratPow(1000, 4),
+		"p":   ratPow(1000, 5),
+		"pb":  ratPow(1000, 5),
+		"e":   ratPow(1000, 6),
+		"eb":  ratPow(1000, 6),
+		"ki":  ratPow(1024, 1),
+		"kib": ratPow(1024, 1),
+		"mi":  ratPow(1024, 2),
+		"mib": ratPow(1024, 2),
+		"gi":  ratPow(1024, 3),
+		"gib": ratPow(1024, 3),
+		"ti":  ratPow(1024, 4),
+		"tib": ratPow(1024, 4),
+		"pi":  ratPow(1024, 5),
+		"pib": ratPow(1024, 5),
+		"ei":  ratPow(1024, 6),
+		"eib": ratPow(1024, 6),
+	}
+
+	// quantityUnits follow the Kubernetes quantity suffixes and are case-sensitive
+	quantityUnits = map[string]*big.Rat{
+		"n":  new(big.Rat).Inv(ratPow(1000, 3)),
+		"u":  new(big.Rat).Inv(ratPow(1000, 2)),
+		"m":  new(big.Rat).Inv(ratPow(1000, 1)),
+		"":   ratPow(1000, 0),
+		"k":  ratPow(1000, 1),
+		"M":  ratPow(1000, 2),
+		"G":  ratPow(1000, 3),
+		"T":  ratPow(1000, 4),
+		"P":  ratPow(1000, 5),
+		"E":  ratPow(1000, 6),
+		"Ki": ratPow(1024, 1),
+		"Mi": ratPow(1024, 2),
+		"Gi": ratPow(1024, 3),
+		"Ti": ratPow(1024, 4),
+		"Pi": ratPow(1024, 5),
+		"Ei": ratPow(1024, 6),
+	}
+)
+
+func ratPow(base int64, exp int64) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(exp), nil))
+}
+
+// parseSuffixed parses a decimal number followed by an optional unit
+// suffix and returns the exact value multiplied by the unit
+func parseSuffixed(s string, units map[string]*big.Rat, foldCase bool) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+
+	number := quantityNumberRegexp.FindString(s)
+	if number == "" {
+		return nil, ErrInvalidNumber
+	}
+
+	suffix := strings.TrimSpace(s[len(number):])
+	if foldCase {
+		suffix = strings.ToLower(suffix)
+	}
+
+	unit, ok := units[suffix]
+	if !ok {
+		return nil, ErrInvalidNumber
+	}
+
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return nil, ErrInvalidNumber
+	}
+
+	return value.Mul(value, unit), nil
+}
+
+// ratToInt64 truncates value towards zero, reporting ErrNotInteger for
+// fractional values and ErrOverflow for values out of the int64 range
+func ratToInt64(value *big.Rat) (int64, error) {
+	i := new(big.Int).Quo(value.Num(), value.Denom())
+
+	if !i.IsInt64() {
+		if i.Sign() < 0 {
+			return math.MinInt64, ErrOverflow
+		}
+
+		return math.MaxInt64, ErrOverflow
+	}
+
+	if !value.IsInt() {
+		return i.Int64(), ErrNotInteger
+	}
+
+	return i.Int64(), nil
+}
+
+// ParseByteSize parses sizes like "512MiB", "1.5GB" or "2Gi". Decimal
+// prefixes (k, M, G, ...) are powers of 1000, binary ones (Ki, Mi,
+// Gi, ...) are powers of 1024, the trailing "B" is optional and case
+// is ignored
+func ParseByteSize(s string) (uint64, error) {
+	value, err := parseSuffixed(s, byteSizeUnits, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if value.Sign() < 0 {
+		return 0, ErrNegative
+	}
+
+	i := new(big.Int).Quo(value.Num(), value.Denom())
+	if !i.IsUint64() {
+		return math.MaxUint64, ErrOverflow
+	}
+
+	if !value.IsInt() {
+		return i.Uint64(), ErrNotInteger
+	}
+
+	return i.Uint64(), nil
+}
+
+// ParseQuantity parses Kubernetes-style quantities like "500m", "2Gi"
+// or "1.5k". Suffixes are case-sensitive: "m" is milli and "M" is mega
+func ParseQuantity(s string) (float64, error) {
+	value, err := parseSuffixed(s, quantityUnits, false)
+	if err != nil {
+		return 0, err
+	}
+
+	f, _ := value.Float64()
+	return f, nil
+}
+
+func ToByteSize(in interface{}) uint64 {
+	castIn, _ := ToByteSizeE(in)
+	return castIn
+}
+
+// ToByteSizeE parses strings with ParseByteSize, other values are
+// treated as a number of bytes
+func ToByteSizeE(in interface{}) (uint64, error) {
+	return NewCaster().ToByteSizeE(in)
+}
+
+func (c *Caster) ToByteSize(in interface{}) uint64 {
+	castIn, _ := c.ToByteSizeE(in)
+	return castIn
+}
+
+func (c *Caster) ToByteSizeE(in interface{}) (uint64, error) {
+	if value, ok, err := unwrapValuer(in); ok {
+		if err != nil {
+			return 0, newCastError(in, uint64Type, err)
+		}
+
+		return c.ToByteSizeE(value)
+	}
+
+	s, ok := stringLike(in)
+	if !ok {
+		return c.ToUint64E(in)
+	}
+
+	castIn, err := ParseByteSize(s)
+	if err != nil {
+		return castIn, newCastError(in, uint64Type, err)
+	}
+
+	return castIn, nil
+}
+
+func ToQuantity(in interface{}) int64 {
+	castIn, _ := ToQuantityE(in)
+	return castIn
+}
+
+// ToQuantityE parses strings with ParseQuantity and truncates the result
+// to an integer, reporting ErrNotInteger if a fractional part is lost
+func ToQuantityE(in interface{}) (int64, error) {
+	return NewCaster().ToQuantityE(in)
+}
+
+func (c *Caster) ToQuantity(in interface{}) int64 {
+	castIn, _ := c.ToQuantityE(in)
+	return castIn
+}
+
+func (c *Caster) ToQuantityE(in interface{}) (int64, error) {
+	if value, ok, err := unwrapValuer(in); ok {
+		if err != nil {
+			return 0, newCastError(in, int64Type, err)
+		}
+
+		return c.ToQuantityE(value)
+	}
+
+	s, ok := stringLike(in)
+	if !ok {
+		return c.ToInt64E(in)
+	}
+
+	value, err := parseSuffixed(s, quantityUnits, false)
+	if err != nil {
+		return 0, newCastError(in, int64Type, err)
+	}
+
+	castIn, err := ratToInt64(value)
+	if err != nil {
+		return castIn, newCastError(in, int64Type, err)
+	}
+
+	return castIn, nil
+}
+
+func ToQuantityFloat64(in interface{}) float64 {
+	castIn, _ := ToQuantityFloat64E(in)
+	return castIn
+}
+
+func ToQuantityFloat64E(in interface{}) (float64, error) {
+	return NewCaster().ToQuantityFloat64E(in)
+}
+
+func (c *Caster) ToQuantityFloat64(in interface{}) float64 {
+	castIn, _ := c.ToQuantityFloat64E(in)
+	return castIn
+}
+
+func (c *Caster) ToQuantityFloat64E(in interface{}) (float64, error) {
+	if value, ok, err := unwrapValuer(in); ok {
+		if err != nil {
+			return 0, newCastError(in, float64Type, err)
+		}
+
+		return c.ToQuantityFloat64E(value)
+	}
+
+	s, ok := stringLike(in)
+	if !ok {
+		return c.ToFloat64E(in)
+	}
+
+	castIn, err := ParseQuantity(s)
+	if err != nil {
+		return castIn, newCastError(in, float64Type, err)
+	}
+
+	return castIn, nil
+}
@@ -0,0 +1,87 @@
+package gotypes
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StringIEC_ToByteSize(t *testing.T) {
+	assert.Exactly(t, ToByteSize("512MiB"), uint64(512*1024*1024))
+	assert.Exactly(t, ToByteSize("2Gi"), uint64(2*1024*1024*1024))
+	assert.Exactly(t, ToByteSize("1 KiB"), uint64(1024))
+	assert.Exactly(t, ToByteSize("1.5kib"), uint64(1536))
+}
+
+func Test_StringSI_ToByteSize(t *testing.T) {
+	assert.Exactly(t, ToByteSize("1.5GB"), uint64(1500000000))
+	assert.Exactly(t, ToByteSize("10k"), uint64(10000))
+	assert.Exactly(t, ToByteSize("100"), uint64(100))
+	assert.Exactly(t, ToByteSize("100b"), uint64(100))
+}
+
+func Test_Number_ToByteSize(t *testing.T) {
+	assert.Exactly(t, ToByteSize(4096), uint64(4096))
+}
+
+func Test_StringInvalid_ToByteSizeError(t *testing.T) {
+	_, err := ToByteSizeE("10 parsecs")
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+
+	_, err = ToByteSizeE("-1MB")
+	assert.ErrorIs(t, err, ErrNegative)
+
+	result, err := ToByteSizeE("0.5B")
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, result, uint64(0))
+
+	_, err = ToByteSizeE("20EiB")
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_StringKubernetes_ToQuantity(t *testing.T) {
+	assert.Exactly(t, ToQuantity("2Gi"), int64(2*1024*1024*1024))
+	assert.Exactly(t, ToQuantity("1.5k"), int64(1500))
+	assert.Exactly(t, ToQuantity("3M"), int64(3000000))
+	assert.Exactly(t, ToQuantity("1e3"), int64(1000))
+}
+
+func Test_StringMilli_ToQuantity(t *testing.T) {
+	result, err := ToQuantityE("500m")
+
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, result, int64(0))
+
+	assert.Exactly(t, ToQuantity("2000m"), int64(2))
+	assert.Exactly(t, ToQuantityFloat64("500m"), 0.5)
+}
+
+func Test_StringCaseSensitive_ToQuantityError(t *testing.T) {
+	_, err := ToQuantityE("1gi")
+
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+}
+
+func Test_ParseQuantity(t *testing.T) {
+	result, err := ParseQuantity("250u")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, 0.00025)
+}
+
+func Test_SQLNullString_ToByteSize(t *testing.T) {
+	result, err := ToByteSizeE(sql.NullString{String: "1KB", Valid: true})
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, uint64(1000))
+
+	_, err = ToByteSizeE(sql.NullString{})
+
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_SQLNullString_ToQuantity(t *testing.T) {
+	assert.Exactly(t, ToQuantity(sql.NullString{String: "2k", Valid: true}), int64(2000))
+	assert.Exactly(t, ToQuantityFloat64(sql.NullString{String: "500m", Valid: true}), 0.5)
+}
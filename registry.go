@@ -0,0 +1,127 @@
+package gotypes
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultRegistry is consulted by the cast functions, To and by
+// converters without their own registry
+var DefaultRegistry = NewRegistry()
+
+// CastFunc converts in to the target type it is registered for
+type CastFunc func(in interface{}) (interface{}, error)
+
+type registryKey struct {
+	from reflect.Type
+	to   reflect.Type
+}
+
+// Registry holds user-defined conversions keyed by the source and the
+// target type. Registered conversions take precedence over the built-in
+// ones and are matched by exact types only, so the source type cannot
+// be an interface
+type Registry struct {
+	mutex sync.RWMutex
+	casts map[registryKey]CastFunc
+	size  int64
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		casts: map[registryKey]CastFunc{},
+	}
+}
+
+// Register adds fn as the conversion from the from type to the to type,
+// replacing the previous one. fn must return values of the to type.
+// Interface source types are rejected as dynamic types never match them
+func (r *Registry) Register(from, to reflect.Type, fn CastFunc) error {
+	if from.Kind() == reflect.Interface {
+		return fmt.Errorf("gotypes: cast from interface type %s: %w", from, ErrUnsupportedType)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.casts[registryKey{from: from, to: to}] = fn
+	atomic.StoreInt64(&r.size, int64(len(r.casts)))
+
+	return nil
+}
+
+func (r *Registry) Unregister(from, to reflect.Type) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.casts, registryKey{from: from, to: to})
+	atomic.StoreInt64(&r.size, int64(len(r.casts)))
+}
+
+func (r *Registry) Lookup(from, to reflect.Type) (CastFunc, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	fn, ok := r.casts[registryKey{from: from, to: to}]
+	return fn, ok
+}
+
+// Cast converts in to the to type with a registered conversion and
+// reports whether there was one. A nil registry has no conversions
+func (r *Registry) Cast(in interface{}, to reflect.Type) (interface{}, bool, error) {
+	if r.empty() || in == nil {
+		return nil, false, nil
+	}
+
+	fn, ok := r.Lookup(reflect.TypeOf(in), to)
+	if !ok {
+		return nil, false, nil
+	}
+
+	castIn, err := fn(in)
+	if err != nil {
+		return castIn, true, newCastError(in, to, err)
+	}
+
+	if castIn == nil || reflect.TypeOf(castIn) != to {
+		return castIn, true, newCastError(in, to, ErrUnsupportedType)
+	}
+
+	return castIn, true, nil
+}
+
+// empty reports whether r has no conversions without locking it, so the
+// casts skip the lookup entirely while nothing is registered
+func (r *Registry) empty() bool {
+	return r == nil || atomic.LoadInt64(&r.size) == 0
+}
+
+// RegisterCast registers a typed conversion from From to To in r
+func RegisterCast[From, To any](r *Registry, fn func(From) (To, error)) error {
+	from := reflect.TypeOf((*From)(nil)).Elem()
+	to := reflect.TypeOf((*To)(nil)).Elem()
+
+	return r.Register(from, to, func(in interface{}) (interface{}, error) {
+		return fn(in.(From))
+	})
+}
+
+// lookupCast casts in to T with a conversion registered in r
+func lookupCast[T any](r *Registry, in interface{}) (castIn T, ok bool, err error) {
+	if r.empty() {
+		return castIn, false, nil
+	}
+
+	value, ok, err := r.Cast(in, reflect.TypeOf((*T)(nil)).Elem())
+	if !ok {
+		return castIn, false, nil
+	}
+
+	if v, valid := value.(T); valid {
+		castIn = v
+	}
+
+	return castIn, true, err
+}
@@ -0,0 +1,124 @@
+package gotypes
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type Money int64
+
+func parseMoney(s string) (Money, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "$")
+
+	value, err := ToBigRatE(s)
+	if err != nil {
+		return 0, errors.Unwrap(err)
+	}
+
+	cents, err := ToInt64E(value.Mul(value, ToBigRat(100)))
+	return Money(cents), errors.Unwrap(err)
+}
+
+func formatMoney(m Money) (string, error) {
+	return "$" + ToString(float64(m)/100), nil
+}
+
+func Test_Registry_Cast(t *testing.T) {
+	registry := NewRegistry()
+	RegisterCast(registry, parseMoney)
+
+	result, ok, err := registry.Cast("$12.34", reflect.TypeOf(Money(0)))
+
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Exactly(t, result, Money(1234))
+
+	_, ok, _ = registry.Cast([]byte("$12.34"), reflect.TypeOf(Money(0)))
+
+	assert.False(t, ok)
+}
+
+func Test_Registry_CastError(t *testing.T) {
+	registry := NewRegistry()
+	RegisterCast(registry, parseMoney)
+
+	_, ok, err := registry.Cast("twelve", reflect.TypeOf(Money(0)))
+
+	assert.True(t, ok)
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+}
+
+func Test_RegistryWrongResultType_CastError(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(stringType, int64Type, func(in interface{}) (interface{}, error) {
+		return int32(1), nil
+	})
+
+	_, _, err := registry.Cast("1", int64Type)
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
+func Test_RegistryUnregister_Cast(t *testing.T) {
+	registry := NewRegistry()
+	RegisterCast(registry, parseMoney)
+	registry.Unregister(stringType, reflect.TypeOf(Money(0)))
+
+	_, ok, _ := registry.Cast("$1", reflect.TypeOf(Money(0)))
+
+	assert.False(t, ok)
+}
+
+func Test_RegistryInterfaceSource_RegisterError(t *testing.T) {
+	registry := NewRegistry()
+
+	err := RegisterCast(registry, func(s fmt.Stringer) (Money, error) {
+		return parseMoney(s.String())
+	})
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+	assert.True(t, registry.empty())
+}
+
+func Test_RegistryEmpty_AfterUnregister(t *testing.T) {
+	registry := NewRegistry()
+
+	assert.True(t, registry.empty())
+	assert.NoError(t, RegisterCast(registry, parseMoney))
+	assert.False(t, registry.empty())
+
+	registry.Unregister(stringType, reflect.TypeOf(Money(0)))
+
+	assert.True(t, registry.empty())
+}
+
+func Test_DefaultRegistry_CastFunctions(t *testing.T) {
+	RegisterCast(DefaultRegistry, formatMoney)
+	RegisterCast(DefaultRegistry, func(m Money) (int64, error) {
+		return int64(m) / 100, nil
+	})
+	defer func() {
+		DefaultRegistry.Unregister(reflect.TypeOf(Money(0)), stringType)
+		DefaultRegistry.Unregister(reflect.TypeOf(Money(0)), int64Type)
+	}()
+
+	assert.Exactly(t, ToString(Money(1234)), "$12.34")
+	assert.Exactly(t, ToInt64(Money(1234)), int64(12))
+	assert.Exactly(t, ToInt8(Money(1234)), int8(12))
+	assert.Exactly(t, ToFloat64(Money(1234)), float64(1234))
+}
+
+func Test_DefaultRegistry_To(t *testing.T) {
+	RegisterCast(DefaultRegistry, parseMoney)
+	defer DefaultRegistry.Unregister(stringType, reflect.TypeOf(Money(0)))
+
+	result, err := To[Money]("$0.5")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, Money(50))
+}
@@ -0,0 +1,125 @@
+package gotypes
+
+import (
+	"math"
+	"math/big"
+)
+
+const (
+	// RoundDefault truncates floats like RoundTruncate, but reports
+	// ErrNotInteger for decimal strings with a fractional part
+	RoundDefault Rounding = iota
+	// RoundTruncate drops the fractional part, rounding towards zero
+	RoundTruncate
+	// RoundHalfUp rounds to the nearest integer, halves away from zero
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest integer, halves to the even one
+	RoundHalfEven
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+	// RoundCeil rounds towards positive infinity
+	RoundCeil
+	// RoundReject truncates like RoundTruncate, but reports ErrNotInteger
+	// if the value has a fractional part
+	RoundReject
+)
+
+// Rounding is a strategy of converting fractional values to integers
+type Rounding int
+
+// Round rounds v to an integral value according to the strategy
+func (r Rounding) Round(v float64) (float64, error) {
+	switch r {
+	case RoundHalfUp:
+		return math.Round(v), nil
+
+	case RoundHalfEven:
+		return math.RoundToEven(v), nil
+
+	case RoundFloor:
+		return math.Floor(v), nil
+
+	case RoundCeil:
+		return math.Ceil(v), nil
+
+	case RoundReject:
+		if t := math.Trunc(v); t != v && !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return t, ErrNotInteger
+		}
+	}
+
+	return math.Trunc(v), nil
+}
+
+// roundRat is the exact counterpart of Round for big.Rat values
+func (r Rounding) roundRat(v *big.Rat) (*big.Int, error) {
+	quo, rem := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo, nil
+	}
+
+	away := false
+
+	switch r {
+	case RoundHalfUp, RoundHalfEven:
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+
+		switch half.Cmp(v.Denom()) {
+		case 1:
+			away = true
+
+		case 0:
+			away = r == RoundHalfUp || quo.Bit(0) == 1
+		}
+
+	case RoundFloor:
+		away = v.Sign() < 0
+
+	case RoundCeil:
+		away = v.Sign() > 0
+
+	case RoundReject:
+		return quo, ErrNotInteger
+	}
+
+	if away {
+		quo.Add(quo, big.NewInt(int64(v.Sign())))
+	}
+
+	return quo, nil
+}
+
+// decimal returns the strategy for decimal strings, which are expected
+// to hold integers unless another strategy is chosen explicitly
+func (r Rounding) decimal() Rounding {
+	if r == RoundDefault {
+		return RoundReject
+	}
+
+	return r
+}
+
+func (r Rounding) ToInt64(in interface{}) int64 {
+	castIn, _ := r.ToInt64E(in)
+	return castIn
+}
+
+func (r Rounding) ToInt64E(in interface{}) (int64, error) {
+	c := NewCaster()
+	c.Rounding = r
+
+	return c.ToInt64E(in)
+}
+
+func (r Rounding) ToUint64(in interface{}) uint64 {
+	castIn, _ := r.ToUint64E(in)
+	return castIn
+}
+
+func (r Rounding) ToUint64E(in interface{}) (uint64, error) {
+	c := NewCaster()
+	c.Rounding = r
+
+	return c.ToUint64E(in)
+}
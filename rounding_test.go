@@ -0,0 +1,91 @@
+package gotypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Rounding_Round(t *testing.T) {
+	cases := []struct {
+		rounding Rounding
+		in       float64
+		out      float64
+	}{
+		{RoundDefault, 2.9, 2},
+		{RoundTruncate, 2.9, 2},
+		{RoundTruncate, -2.9, -2},
+		{RoundHalfUp, 2.5, 3},
+		{RoundHalfUp, -2.5, -3},
+		{RoundHalfUp, 2.4, 2},
+		{RoundHalfEven, 2.5, 2},
+		{RoundHalfEven, 3.5, 4},
+		{RoundFloor, -2.1, -3},
+		{RoundCeil, 2.1, 3},
+		{RoundReject, 2, 2},
+	}
+
+	for _, c := range cases {
+		result, err := c.rounding.Round(c.in)
+
+		assert.NoError(t, err)
+		assert.Exactly(t, result, c.out)
+	}
+}
+
+func Test_RoundReject_RoundError(t *testing.T) {
+	result, err := RoundReject.Round(2.5)
+
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, result, float64(2))
+}
+
+func Test_FloatHalfUp_ToInt64(t *testing.T) {
+	assert.Exactly(t, RoundHalfUp.ToInt64(2.9), int64(3))
+	assert.Exactly(t, RoundHalfUp.ToInt64("2.5"), int64(3))
+	assert.Exactly(t, RoundHalfUp.ToInt64(float32(1.5)), int64(2))
+}
+
+func Test_StringCeil_ToUint64(t *testing.T) {
+	assert.Exactly(t, RoundCeil.ToUint64("2.1"), uint64(3))
+	assert.Exactly(t, RoundCeil.ToUint64("7"), uint64(7))
+}
+
+func Test_StringReject_ToInt64Error(t *testing.T) {
+	result, err := RoundReject.ToInt64E("2.9")
+
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, result, int64(2))
+}
+
+func Test_StringLargeInteger_RoundingKeepsPrecision(t *testing.T) {
+	assert.Exactly(t, RoundHalfUp.ToInt64("9007199254740993"), int64(9007199254740993))
+}
+
+func Test_DefaultRounding_ToInt64(t *testing.T) {
+	result, err := ToInt64E(2.9)
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(2))
+
+	result, err = ToInt64E("2.9")
+
+	assert.ErrorIs(t, err, ErrNotInteger)
+	assert.Exactly(t, result, int64(2))
+}
+
+func Test_CasterRounding_ToInt64(t *testing.T) {
+	c := NewCaster()
+	c.Rounding = RoundHalfEven
+
+	assert.Exactly(t, c.ToInt64(2.5), int64(2))
+	assert.Exactly(t, c.ToInt64("3.5"), int64(4))
+	assert.Exactly(t, c.ToUint8(254.5), uint8(254))
+}
+
+func Test_StringTruncate_ToInt64(t *testing.T) {
+	result, err := RoundTruncate.ToInt64E("2.9")
+
+	assert.NoError(t, err)
+	assert.Exactly(t, result, int64(2))
+}
@@ -0,0 +1,41 @@
+package gotypes
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+)
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// unwrapValuer returns the value of driver.Valuer inputs, such as
+// sql.NullString or sql.NullInt64. Invalid (NULL) values are reported
+// with ErrNil, the same way as nil pointers
+func unwrapValuer(in interface{}) (interface{}, bool, error) {
+	valuer, ok := in.(driver.Valuer)
+	if !ok {
+		return in, false, nil
+	}
+
+	value, err := valuer.Value()
+	if err == nil && value == nil {
+		err = ErrNil
+	}
+
+	return value, true, err
+}
+
+// isNullType reports whether t is one of the sql.Null* types or alike:
+// a struct implementing sql.Scanner by pointer with a value field
+// followed by a Valid flag
+func isNullType(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.NumField() != 2 || !reflect.PtrTo(t).Implements(scannerType) {
+		return false
+	}
+
+	valid := t.Field(1)
+
+	return valid.Name == "Valid" && valid.Type.Kind() == reflect.Bool
+}
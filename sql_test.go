@@ -0,0 +1,66 @@
+package gotypes
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("connection lost")
+}
+
+func Test_SQLNullTypes_ToString(t *testing.T) {
+	assert.Exactly(t, ToString(sql.NullString{String: "foo", Valid: true}), "foo")
+	assert.Exactly(t, ToString(sql.NullInt64{Int64: 42, Valid: true}), "42")
+	assert.Exactly(t, ToString(sql.NullBool{Bool: true, Valid: true}), "true")
+	assert.Exactly(t, ToString(&sql.NullFloat64{Float64: 1.5, Valid: true}), "1.5")
+	assert.Exactly(t, ToString(sql.NullTime{Time: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}), "2020-01-02T03:04:05Z")
+}
+
+func Test_SQLNullTypes_ToNumbers(t *testing.T) {
+	assert.Exactly(t, ToInt64(sql.NullString{String: "42", Valid: true}), int64(42))
+	assert.Exactly(t, ToUint8(sql.NullInt32{Int32: 7, Valid: true}), uint8(7))
+	assert.Exactly(t, ToFloat64(sql.NullInt64{Int64: 3, Valid: true}), float64(3))
+	assert.True(t, ToBool(sql.NullInt64{Int64: 1, Valid: true}))
+	assert.Exactly(t, ToBigInt(sql.NullString{String: "12345678901234567890", Valid: true}).String(), "12345678901234567890")
+}
+
+func Test_SQLNullTypes_ToTimeAndDuration(t *testing.T) {
+	assert.Exactly(t, ToTime(sql.NullString{String: "2020-01-02", Valid: true}), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+	assert.Exactly(t, ToDuration(sql.NullString{String: "1m", Valid: true}), time.Minute)
+}
+
+func Test_InvalidSQLNullTypes_NilError(t *testing.T) {
+	_, err := ToStringE(sql.NullString{String: "foo"})
+	assert.ErrorIs(t, err, ErrNil)
+
+	_, err = ToInt64E(sql.NullInt64{Int64: 42})
+	assert.ErrorIs(t, err, ErrNil)
+
+	_, err = ToTimeE(sql.NullTime{})
+	assert.ErrorIs(t, err, ErrNil)
+
+	_, err = ToComplex128E(sql.NullFloat64{})
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_NilSQLNullPointer_ToStringError(t *testing.T) {
+	var in *sql.NullString
+
+	_, err := ToStringE(in)
+
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func Test_FailingValuer_ToInt64Error(t *testing.T) {
+	_, err := ToInt64E(failingValuer{})
+
+	assert.EqualError(t, err, "gotypes: unable to cast gotypes.failingValuer{} of type gotypes.failingValuer to int64: connection lost")
+}
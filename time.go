@@ -0,0 +1,205 @@
+package gotypes
+
+import (
+	"math"
+	"math/big"
+	"sync"
+	"time"
+)
+
+const (
+	DateTimeLayout    = "2006-01-02 15:04:05"
+	DateLayout        = "2006-01-02"
+	DotDateTimeLayout = "02.01.2006 15:04:05"
+)
+
+// DefaultTimeLayouts is used by ToTime and ToTimeE
+var DefaultTimeLayouts = NewTimeLayouts(
+	time.RFC3339,
+	time.RFC3339Nano,
+	DotDateTimeLayout,
+	DateTimeLayout,
+	DateLayout,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+	time.Kitchen,
+)
+
+// TimeLayouts is an ordered list of layouts tried one by one when
+// parsing a string into time.Time
+type TimeLayouts struct {
+	mutex   sync.RWMutex
+	layouts []string
+}
+
+func NewTimeLayouts(layouts ...string) *TimeLayouts {
+	return &TimeLayouts{
+		layouts: append([]string(nil), layouts...),
+	}
+}
+
+// Layouts returns a copy of the layouts in the order they are tried
+func (l *TimeLayouts) Layouts() []string {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return append([]string(nil), l.layouts...)
+}
+
+// Set replaces all layouts
+func (l *TimeLayouts) Set(layouts ...string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.layouts = append([]string(nil), layouts...)
+}
+
+// Add appends layouts to the end of the list, moving already known ones
+func (l *TimeLayouts) Add(layouts ...string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.layouts = append(l.without(layouts), layouts...)
+}
+
+// Prepend puts layouts at the beginning of the list, so they are tried first
+func (l *TimeLayouts) Prepend(layouts ...string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.layouts = append(append([]string(nil), layouts...), l.without(layouts)...)
+}
+
+func (l *TimeLayouts) Remove(layouts ...string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.layouts = l.without(layouts)
+}
+
+func (l *TimeLayouts) without(layouts []string) []string {
+	result := make([]string, 0, len(l.layouts))
+
+	for _, layout := range l.layouts {
+		found := false
+
+		for _, exclude := range layouts {
+			if layout == exclude {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, layout)
+		}
+	}
+
+	return result
+}
+
+// Unix converts a Unix timestamp expressed in unit to time.Time. If unit
+// is zero, it is detected from the magnitude of v. ErrOverflow is
+// returned if the seconds do not fit into int64
+func (l *TimeLayouts) Unix(v int64, unit time.Duration) (time.Time, error) {
+	if unit <= 0 {
+		unit = detectUnixUnit(math.Abs(float64(v)))
+	}
+
+	sec, nsec := new(big.Int).DivMod(
+		new(big.Int).Mul(big.NewInt(v), big.NewInt(int64(unit))),
+		big.NewInt(int64(time.Second)),
+		new(big.Int),
+	)
+
+	if !sec.IsInt64() {
+		return time.Time{}, ErrOverflow
+	}
+
+	return time.Unix(sec.Int64(), nsec.Int64()).UTC(), nil
+}
+
+// UnixFloat is the same as Unix but keeps the fractional part of v
+func (l *TimeLayouts) UnixFloat(v float64, unit time.Duration) (time.Time, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return time.Time{}, ErrNaN
+	}
+
+	if unit <= 0 {
+		unit = detectUnixUnit(math.Abs(v))
+	}
+
+	sec, frac := math.Modf(v * float64(unit) / float64(time.Second))
+	if sec < math.MinInt64 || sec >= math.MaxInt64 {
+		return time.Time{}, ErrOverflow
+	}
+
+	return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+}
+
+// detectUnixUnit guesses the unit of a timestamp, assuming it lies
+// within a few thousand years of the epoch
+func detectUnixUnit(v float64) time.Duration {
+	switch {
+	case v < 1e11:
+		return time.Second
+
+	case v < 1e14:
+		return time.Millisecond
+
+	case v < 1e17:
+		return time.Microsecond
+	}
+
+	return time.Nanosecond
+}
+
+// Parse returns the time from the first layout matching s
+func (l *TimeLayouts) Parse(s string) (time.Time, error) {
+	return l.ParseInLocation(s, time.UTC)
+}
+
+// ParseInLocation is the same as Parse, but layouts without a time zone
+// are interpreted in loc
+func (l *TimeLayouts) ParseInLocation(s string, loc *time.Location) (time.Time, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	for _, layout := range l.layouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrUnknownTimeLayout
+}
+
+func (l *TimeLayouts) ToTime(in interface{}) time.Time {
+	t, _ := l.ToTimeE(in)
+	return t
+}
+
+func (l *TimeLayouts) ToTimeE(in interface{}) (time.Time, error) {
+	c := NewCaster()
+	c.TimeLayouts = l
+
+	return c.ToTimeE(in)
+}
+
+func (l *TimeLayouts) ToTimeIn(in interface{}, loc *time.Location) time.Time {
+	t, _ := l.ToTimeInE(in, loc)
+	return t
+}
+
+func (l *TimeLayouts) ToTimeInE(in interface{}, loc *time.Location) (time.Time, error) {
+	c := NewCaster()
+	c.TimeLayouts = l
+
+	return c.ToTimeInE(in, loc)
+}
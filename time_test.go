@@ -0,0 +1,165 @@
+package gotypes
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StringSQLDateTime_ToTime(t *testing.T) {
+	result, err := ToTimeE("2016-08-19 18:55:00")
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2016, time.August, 19, 18, 55, 0, 0, time.UTC))
+}
+
+func Test_StringISODate_ToTime(t *testing.T) {
+	result, err := ToTimeE("2016-08-19")
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2016, time.August, 19, 0, 0, 0, 0, time.UTC))
+}
+
+func Test_StringRFC1123_ToTime(t *testing.T) {
+	result, err := ToTimeE("Fri, 19 Aug 2016 18:55:00 UTC")
+
+	assert.NoError(t, err)
+	assert.True(t, result.Equal(time.Date(2016, time.August, 19, 18, 55, 0, 0, time.UTC)))
+}
+
+func Test_StringRFC3339Nano_ToTime(t *testing.T) {
+	result, err := ToTimeE("2016-08-19T18:55:00.123456789Z")
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2016, time.August, 19, 18, 55, 0, 123456789, time.UTC))
+}
+
+func Test_StringCustomLayout_ToTime(t *testing.T) {
+	layouts := NewTimeLayouts(time.RFC3339)
+
+	_, err := layouts.ToTimeE("19/08/2016")
+	assert.ErrorIs(t, err, ErrUnknownTimeLayout)
+
+	layouts.Add("02/01/2006")
+
+	result, err := layouts.ToTimeE("19/08/2016")
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2016, time.August, 19, 0, 0, 0, 0, time.UTC))
+}
+
+func Test_TimeLayoutsOrder(t *testing.T) {
+	layouts := NewTimeLayouts("01/02/2006", "02/01/2006")
+
+	assert.Equal(t, layouts.ToTime("03/04/2016").Month(), time.March)
+
+	layouts.Prepend("02/01/2006")
+	assert.Equal(t, layouts.Layouts(), []string{"02/01/2006", "01/02/2006"})
+	assert.Equal(t, layouts.ToTime("03/04/2016").Month(), time.April)
+
+	layouts.Remove("02/01/2006")
+	layouts.Add("2006")
+	assert.Equal(t, layouts.Layouts(), []string{"01/02/2006", "2006"})
+
+	layouts.Set("2006")
+	assert.Equal(t, layouts.Layouts(), []string{"2006"})
+}
+
+func Test_Time_ToTime(t *testing.T) {
+	val := time.Date(2016, time.August, 19, 18, 55, 0, 0, time.FixedZone("", 3*60*60))
+
+	assert.Equal(t, ToTime(val), val)
+	assert.Equal(t, ToTime(&val), val)
+}
+
+func Test_IntUnixSeconds_ToTime(t *testing.T) {
+	result, err := ToTimeE(1700000000)
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC))
+}
+
+func Test_IntUnixMilliseconds_ToTime(t *testing.T) {
+	result := ToTime(int64(1700000000123))
+
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 123000000, time.UTC))
+}
+
+func Test_IntUnixMicroseconds_ToTime(t *testing.T) {
+	result := ToTime(uint64(1700000000123456))
+
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 123456000, time.UTC))
+}
+
+func Test_IntUnixNanoseconds_ToTime(t *testing.T) {
+	result := ToTime(int64(1700000000123456789))
+
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 123456789, time.UTC))
+}
+
+func Test_FloatUnixSeconds_ToTime(t *testing.T) {
+	result := ToTime(1700000000.5)
+
+	assert.Equal(t, result, time.Date(2023, time.November, 14, 22, 13, 20, 500000000, time.UTC))
+}
+
+func Test_IntExplicitUnixUnit_ToTime(t *testing.T) {
+	c := NewCaster()
+	c.UnixUnit = time.Millisecond
+
+	result := c.ToTime(1000)
+
+	assert.Equal(t, result, time.Date(1970, time.January, 1, 0, 0, 1, 0, time.UTC))
+}
+
+func Test_IntUnevenUnixUnit_ToTime(t *testing.T) {
+	c := NewCaster()
+	c.UnixUnit = 1500 * time.Millisecond
+
+	result, err := c.ToTimeE(3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(1970, time.January, 1, 0, 0, 4, 500000000, time.UTC))
+}
+
+func Test_IntUnixOverflow_ToTimeError(t *testing.T) {
+	c := NewCaster()
+	c.UnixUnit = time.Hour
+
+	_, err := c.ToTimeE(int64(math.MaxInt64))
+
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func Test_FloatNaN_ToTimeError(t *testing.T) {
+	_, err := ToTimeE(math.NaN())
+
+	assert.ErrorIs(t, err, ErrNaN)
+}
+
+func Test_StringWithoutZone_ToTimeIn(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+
+	result, err := ToTimeInE("19.08.2016 18:55:00", loc)
+
+	assert.NoError(t, err)
+	assert.Equal(t, result, time.Date(2016, time.August, 19, 18, 55, 0, 0, loc))
+}
+
+func Test_StringWithZone_ToTimeIn(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+
+	result := ToTimeIn("2016-08-19T18:55:00Z", loc)
+
+	assert.True(t, result.Equal(time.Date(2016, time.August, 19, 18, 55, 0, 0, time.UTC)))
+	assert.Equal(t, result.Location(), time.UTC)
+}
+
+func Test_IntUnixSeconds_ToTimeIn(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+
+	result := ToTimeIn(0, loc)
+
+	assert.Equal(t, result, time.Date(1970, time.January, 1, 3, 0, 0, 0, loc))
+}